@@ -0,0 +1,96 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	paramAffiliateCustomID     = "affiliate.customId"
+	paramAffiliateGeoTargeting = "affiliate.geoTargeting"
+	paramAffiliateNetworkID    = "affiliate.networkId"
+	paramAffiliateTrackingID   = "affiliate.trackingId"
+	paramBuyerPostalCode       = "buyerPostalCode"
+	ebayPartnerNetworkID       = "9"
+	campaignIDLen              = 10
+)
+
+var (
+	// ErrIncompleteAffiliateParams is returned when an affiliate is missing its network ID or tracking ID.
+	ErrIncompleteAffiliateParams = errors.New("ebay: affiliate network ID and tracking ID must be specified together")
+
+	// ErrInvalidCampaignID is returned when an eBay Partner Network tracking ID is not a 10-digit campaign ID.
+	ErrInvalidCampaignID = fmt.Errorf("ebay: eBay Partner Network tracking ID must be a %d-digit campaign ID", campaignIDLen)
+
+	// ErrMissingBuyerPostalCode is returned when affiliate geo-targeting is enabled without a buyer postal code.
+	ErrMissingBuyerPostalCode = errors.New("ebay: affiliate geo-targeting requires a buyer postal code")
+)
+
+// Validate reports whether a is a complete affiliate configuration.
+//
+// If any affiliate field is set, NetworkID and TrackingID are both required, otherwise
+// Validate returns [ErrIncompleteAffiliateParams]. If NetworkID is 9, the eBay Partner Network,
+// TrackingID must be a 10-digit campaign ID, otherwise Validate returns [ErrInvalidCampaignID].
+func (a Affiliate) Validate() error {
+	if a == (Affiliate{}) {
+		return nil
+	}
+	if a.NetworkID == "" || a.TrackingID == "" {
+		return ErrIncompleteAffiliateParams
+	}
+	if a.NetworkID == ebayPartnerNetworkID && !isDigits(a.TrackingID, campaignIDLen) {
+		return fmt.Errorf("%w: %q", ErrInvalidCampaignID, a.TrackingID)
+	}
+	return nil
+}
+
+// validateAffiliateParams validates the affiliate params in params.
+// Since geo-targeting is only meaningful for a complete affiliate, a geoTargeting param
+// requires the networkId and trackingId params, even if it is false. Since eBay geo-targets
+// using the buyer's location, a geoTargeting param of true also requires the buyerPostalCode param.
+func validateAffiliateParams(params map[string]string) error {
+	geoTargeting, _ := strconv.ParseBool(params[paramAffiliateGeoTargeting])
+	a := Affiliate{
+		CustomID:     params[paramAffiliateCustomID],
+		GeoTargeting: geoTargeting,
+		NetworkID:    params[paramAffiliateNetworkID],
+		TrackingID:   params[paramAffiliateTrackingID],
+	}
+	err := a.Validate()
+	if _, ok := params[paramAffiliateGeoTargeting]; ok && err == nil && (a.NetworkID == "" || a.TrackingID == "") {
+		err = ErrIncompleteAffiliateParams
+	}
+	switch {
+	case errors.Is(err, ErrIncompleteAffiliateParams):
+		key := paramAffiliateTrackingID
+		if a.NetworkID == "" {
+			key = paramAffiliateNetworkID
+		}
+		return &ValidationError{Err: ErrIncompleteAffiliateParams, Key: key, Value: params[key]}
+	case errors.Is(err, ErrInvalidCampaignID):
+		return &ValidationError{Err: ErrInvalidCampaignID, Key: paramAffiliateTrackingID, Value: a.TrackingID}
+	case err != nil:
+		return err
+	}
+	if a.GeoTargeting && strings.TrimSpace(params[paramBuyerPostalCode]) == "" {
+		return &ValidationError{Err: ErrMissingBuyerPostalCode, Key: paramBuyerPostalCode, Value: params[paramBuyerPostalCode]}
+	}
+	return nil
+}
+
+func isDigits(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAffiliate_Validate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name      string
+		Affiliate Affiliate
+		Err       error
+	}{
+		{Name: "Empty", Affiliate: Affiliate{}},
+		{Name: "EPN", Affiliate: Affiliate{NetworkID: "9", TrackingID: "1234567890"}},
+		{Name: "OtherNetwork", Affiliate: Affiliate{NetworkID: "2", TrackingID: "abc"}},
+		{Name: "MissingTrackingID", Affiliate: Affiliate{NetworkID: "9"}, Err: ErrIncompleteAffiliateParams},
+		{Name: "MissingNetworkID", Affiliate: Affiliate{TrackingID: "1234567890"}, Err: ErrIncompleteAffiliateParams},
+		{Name: "CustomIDOnly", Affiliate: Affiliate{CustomID: "abc"}, Err: ErrIncompleteAffiliateParams},
+		{Name: "ShortCampaignID", Affiliate: Affiliate{NetworkID: "9", TrackingID: "123456789"}, Err: ErrInvalidCampaignID},
+		{Name: "NonNumericCampaignID", Affiliate: Affiliate{NetworkID: "9", TrackingID: "12345abcde"}, Err: ErrInvalidCampaignID},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if err := tc.Affiliate.Validate(); !errors.Is(err, tc.Err) {
+				t.Errorf("Affiliate.Validate() error = %v, want %v", err, tc.Err)
+			}
+		})
+	}
+}
+
+func TestFindingClient_AffiliateValidation(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name   string
+		Params map[string]string
+		Err    error
+	}{
+		{
+			Name:   "IncompleteAffiliateParams",
+			Params: map[string]string{"keywords": "testword", "affiliate.networkId": "9"},
+			Err:    ErrIncompleteAffiliateParams,
+		},
+		{
+			Name:   "GeoTargetingOnly",
+			Params: map[string]string{"keywords": "testword", "affiliate.geoTargeting": "true"},
+			Err:    ErrIncompleteAffiliateParams,
+		},
+		{
+			Name:   "GeoTargetingFalseOnly",
+			Params: map[string]string{"keywords": "testword", "affiliate.geoTargeting": "false"},
+			Err:    ErrIncompleteAffiliateParams,
+		},
+		{
+			Name: "GeoTargetingWithoutBuyerPostalCode",
+			Params: map[string]string{
+				"keywords": "testword", "affiliate.networkId": "9", "affiliate.trackingId": "1234567890",
+				"affiliate.geoTargeting": "true",
+			},
+			Err: ErrMissingBuyerPostalCode,
+		},
+		{
+			Name:   "InvalidCampaignID",
+			Params: map[string]string{"keywords": "testword", "affiliate.networkId": "9", "affiliate.trackingId": "123"},
+			Err:    ErrInvalidCampaignID,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+			client.URL = "http://localhost"
+			_, err := client.FindItemsByKeywords(context.Background(), tc.Params)
+			if !errors.Is(err, tc.Err) {
+				t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, tc.Err)
+			}
+			_, err = client.BuildRequestURL(context.Background(), OperationByKeywords, tc.Params)
+			if !errors.Is(err, tc.Err) {
+				t.Errorf("FindingClient.BuildRequestURL() error = %v, want %v", err, tc.Err)
+			}
+		})
+	}
+}
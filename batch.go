@@ -0,0 +1,65 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+)
+
+// FindItemsByKeywordsBatch searches for items on eBay for each of keywords, using base as the
+// params of every search with its keywords param replaced. At most concurrency searches are in
+// flight at once; a concurrency less than 1 runs the searches one at a time. The base params are
+// not modified.
+//
+// The responses are returned in the order of keywords. If any search fails, the response at its
+// index is nil and the returned error joins the error of each failed search. A search whose
+// response has a PartialFailure ack keeps the results eBay returned at its index, and its
+// error wraps [ErrPartialFailure]. Once ctx is done, no further searches are started and each
+// unstarted search fails with the context's error.
+func (c *FindingClient) FindItemsByKeywordsBatch(
+	ctx context.Context, base map[string]string, keywords []string, concurrency int,
+) ([]*FindItemsByKeywordsResponse, error) {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
+	concurrency = max(concurrency, 1)
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, concurrency)
+		res  = make([]*FindItemsByKeywordsResponse, len(keywords))
+		errs = make([]error, len(keywords))
+	)
+	for i, kw := range keywords {
+		if err := ctx.Err(); err != nil {
+			errs[i] = fmt.Errorf("keywords[%d] %q: %w", i, kw, err)
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = fmt.Errorf("keywords[%d] %q: %w", i, kw, ctx.Err())
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			params := maps.Clone(base)
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params["keywords"] = kw
+			r, err := c.FindItemsByKeywords(ctx, params)
+			if err != nil {
+				errs[i] = fmt.Errorf("keywords[%d] %q: %w", i, kw, err)
+			}
+			res[i] = r
+		}()
+	}
+	wg.Wait()
+	return res, errors.Join(errs...)
+}
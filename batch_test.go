@@ -0,0 +1,135 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFindingClient_FindItemsByKeywordsBatch(t *testing.T) {
+	t.Parallel()
+	newServer := func(t *testing.T, maxInFlight *atomic.Int32) *httptest.Server {
+		t.Helper()
+		var inFlight atomic.Int32
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			kw := r.URL.Query().Get("keywords")
+			if kw == "fail" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			res := FindItemsByKeywordsResponse{ItemsResponse: []FindItemsResponse{{ItemSearchURL: []string{kw}}}}
+			if err := json.NewEncoder(w).Encode(&res); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+	}
+	t.Run("ResultsInOrder", func(t *testing.T) {
+		t.Parallel()
+		var maxInFlight atomic.Int32
+		ts := newServer(t, &maxInFlight)
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		base := map[string]string{"categoryId": "9355"}
+		keywords := []string{"a", "b", "c", "d", "e", "f"}
+		got, err := client.FindItemsByKeywordsBatch(context.Background(), base, keywords, 2)
+		if err != nil {
+			t.Fatalf("FindingClient.FindItemsByKeywordsBatch() error = %v, want nil", err)
+		}
+		for i, kw := range keywords {
+			if url := got[i].ItemsResponse[0].ItemSearchURL[0]; url != kw {
+				t.Errorf("FindingClient.FindItemsByKeywordsBatch()[%d] keywords = %q, want %q", i, url, kw)
+			}
+		}
+		if n := maxInFlight.Load(); n > 2 {
+			t.Errorf("server received %d concurrent requests, want at most 2", n)
+		}
+		if _, ok := base["keywords"]; ok {
+			t.Errorf("FindingClient.FindItemsByKeywordsBatch() modified base: %v", base)
+		}
+	})
+
+	t.Run("AggregatesErrors", func(t *testing.T) {
+		t.Parallel()
+		var maxInFlight atomic.Int32
+		ts := newServer(t, &maxInFlight)
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		got, err := client.FindItemsByKeywordsBatch(context.Background(), nil, []string{"a", "fail", "c"}, 3)
+		if !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("FindingClient.FindItemsByKeywordsBatch() error = %v, want %v", err, ErrInvalidStatus)
+		}
+		if got[0] == nil || got[1] != nil || got[2] == nil {
+			t.Errorf("FindingClient.FindItemsByKeywordsBatch() = %v, want nil only at index 1", got)
+		}
+	})
+
+	t.Run("KeepsPartialResults", func(t *testing.T) {
+		t.Parallel()
+		partial, err := os.ReadFile(filepath.Join("testdata", "partial_failure_response.json"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			body := []byte(`{"findItemsByKeywordsResponse":[{"ack":["Success"]}]}`)
+			if r.URL.Query().Get("keywords") == "partial" {
+				body = partial
+			}
+			if _, err := w.Write(body); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		got, err := client.FindItemsByKeywordsBatch(context.Background(), nil, []string{"a", "partial"}, 2)
+		if !errors.Is(err, ErrPartialFailure) {
+			t.Errorf("FindingClient.FindItemsByKeywordsBatch() error = %v, want %v", err, ErrPartialFailure)
+		}
+		if got[0] == nil {
+			t.Error("FindingClient.FindItemsByKeywordsBatch()[0] = nil, want response")
+		}
+		if got[1] == nil || got[1].ItemsResponse[0].ItemCount() != 1 {
+			t.Errorf("FindingClient.FindItemsByKeywordsBatch()[1] = %v, want partial results", got[1])
+		}
+	})
+
+	t.Run("CanceledContext", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.URL = "http://localhost"
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		got, err := client.FindItemsByKeywordsBatch(ctx, nil, []string{"a", "b", "c"}, 1)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("FindingClient.FindItemsByKeywordsBatch() error = %v, want %v", err, context.Canceled)
+		}
+		for i, r := range got {
+			if r != nil {
+				t.Errorf("FindingClient.FindItemsByKeywordsBatch()[%d] = %v, want nil", i, r)
+			}
+		}
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"strconv"
+	"strings"
+)
+
+const itemFilterCondition = "Condition"
+
+// nativeConditionNames are the condition names the Condition item filter accepts as values.
+// Each matches a group of condition IDs; Used, for example, matches every used condition.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/ItemFilterType.html.
+var nativeConditionNames = []string{"New", "Used", "Unspecified"}
+
+// conditionIDs maps the lowercase item condition names to their condition IDs.
+// See https://developer.ebay.com/Devzone/finding/CallRef/Enums/conditionIdList.html.
+var conditionIDs = map[string]int{
+	"new":                      1000,
+	"new other":                1500,
+	"new other (see details)":  1500,
+	"new with defects":         1750,
+	"certified refurbished":    2000,
+	"certified - refurbished":  2000,
+	"manufacturer refurbished": 2000,
+	"excellent - refurbished":  2010,
+	"very good - refurbished":  2020,
+	"good - refurbished":       2030,
+	"seller refurbished":       2500,
+	"like new":                 2750,
+	"used":                     3000,
+	"very good":                4000,
+	"good":                     5000,
+	"acceptable":               6000,
+	"for parts":                7000,
+	"for parts or not working": 7000,
+}
+
+// ConditionIDForName returns the condition ID of the item condition name, such as 1000 for New
+// or 7000 for For parts or not working. The name is matched case-insensitively.
+// ConditionIDForName reports false if name is not a known condition.
+func ConditionIDForName(name string) (int, bool) {
+	id, ok := conditionIDs[strings.ToLower(strings.TrimSpace(name))]
+	return id, ok
+}
+
+// conditionIDParams returns the Condition item filter values in params that are condition
+// names eBay does not accept, such as For parts, replaced by their condition IDs.
+// The names eBay accepts natively are left as is, as their matches are broader than any ID.
+func conditionIDParams(params map[string]string) map[string]string {
+	m := make(map[string]string)
+	for k, v := range params {
+		prefix, ok := strings.CutSuffix(k, ".name")
+		if !ok || !strings.HasPrefix(prefix, "itemFilter") || v != itemFilterCondition {
+			continue
+		}
+		for vk, vv := range params {
+			rest, ok := strings.CutPrefix(vk, prefix+".value")
+			if !ok || (rest != "" && rest[0] != '(') || isNativeConditionName(vv) {
+				continue
+			}
+			if id, ok := ConditionIDForName(vv); ok {
+				m[vk] = strconv.Itoa(id)
+			}
+		}
+	}
+	return m
+}
+
+func isNativeConditionName(name string) bool {
+	for _, n := range nativeConditionNames {
+		if strings.EqualFold(strings.TrimSpace(name), n) {
+			return true
+		}
+	}
+	return false
+}
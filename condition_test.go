@@ -0,0 +1,88 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestConditionIDForName(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name   string
+		WantID int
+		WantOK bool
+	}{
+		{Name: "New", WantID: 1000, WantOK: true},
+		{Name: "used", WantID: 3000, WantOK: true},
+		{Name: " For parts or not working ", WantID: 7000, WantOK: true},
+		{Name: "For parts", WantID: 7000, WantOK: true},
+		{Name: "Like New", WantID: 2750, WantOK: true},
+		{Name: "1000", WantID: 0, WantOK: false},
+		{Name: "Mint", WantID: 0, WantOK: false},
+	}
+	for _, tc := range testCases {
+		id, ok := ConditionIDForName(tc.Name)
+		if id != tc.WantID || ok != tc.WantOK {
+			t.Errorf("ConditionIDForName(%q) = %d, %t, want %d, %t", tc.Name, id, ok, tc.WantID, tc.WantOK)
+		}
+	}
+}
+
+func TestFindingClient_NormalizeConditionNames(t *testing.T) {
+	t.Parallel()
+	params := BuildItemFilterParams([]ItemFilter{
+		{Name: "Condition", Values: []string{"New", "Used", "3000", "for parts", "Like New"}},
+		{Name: "Seller", Values: []string{"for parts"}},
+	})
+	testCases := []struct {
+		Name      string
+		Normalize bool
+		Want      map[string]string
+	}{
+		{
+			Name:      "Enabled",
+			Normalize: true,
+			Want: map[string]string{
+				"itemFilter(0).value(0)": "New",
+				"itemFilter(0).value(1)": "Used",
+				"itemFilter(0).value(2)": "3000",
+				"itemFilter(0).value(3)": "7000",
+				"itemFilter(0).value(4)": "2750",
+				"itemFilter(1).value(0)": "for parts",
+			},
+		},
+		{
+			Name: "Disabled",
+			Want: map[string]string{
+				"itemFilter(0).value(3)": "for parts",
+				"itemFilter(0).value(4)": "Like New",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+			client.NormalizeConditionNames = tc.Normalize
+			got, err := client.BuildRequestURL(context.Background(), OperationByKeywords, params)
+			if err != nil {
+				t.Fatalf("FindingClient.BuildRequestURL() error = %v, want nil", err)
+			}
+			u, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("url.Parse() error = %v, want nil", err)
+			}
+			qry := u.Query()
+			for k, v := range tc.Want {
+				if qry.Get(k) != v {
+					t.Errorf("FindingClient.BuildRequestURL() = %s, want %s=%s", got, k, v)
+				}
+			}
+		})
+	}
+}
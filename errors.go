@@ -0,0 +1,163 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxErrorBodySize is the maximum number of bytes read from an error response body.
+const maxErrorBodySize = 1 << 16
+
+// An APIError is returned when the eBay Finding API responds with a non-200 status code.
+// It wraps [ErrInvalidStatus].
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Errors contains the error details decoded from the response body, if any.
+	Errors []ErrorData
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s: %d", ErrInvalidStatus, e.StatusCode)
+	if details := errorMessages(e.Errors); details != "" {
+		return msg + ": " + details
+	}
+	return msg
+}
+
+func (e *APIError) Unwrap() error {
+	return ErrInvalidStatus
+}
+
+// An AckError is returned when the eBay Finding API responds with a Failure or
+// PartialFailure ack. It wraps [ErrAckFailure] or [ErrPartialFailure], respectively.
+type AckError struct {
+	// Ack is the ack value of the response, AckFailure or AckPartialFailure.
+	Ack Ack
+
+	// Errors contains the error details of the response, if any.
+	Errors []ErrorData
+}
+
+func (e *AckError) Error() string {
+	msg := e.Unwrap().Error()
+	if details := errorMessages(e.Errors); details != "" {
+		return msg + ": " + details
+	}
+	return msg
+}
+
+func (e *AckError) Unwrap() error {
+	if e.Ack == AckPartialFailure {
+		return ErrPartialFailure
+	}
+	return ErrAckFailure
+}
+
+// IsTransient reports whether the failure is caused only by eBay System errors, which may
+// not recur if the request is retried, rather than by errors in the request itself.
+func (e *AckError) IsTransient() bool {
+	if len(e.Errors) == 0 {
+		return false
+	}
+	for _, d := range e.Errors {
+		if !d.IsSystemError() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsRetryable reports whether err, returned by a [FindingClient] method, is a transient
+// failure that may not recur if the call is retried: a failed request that was not canceled
+// and did not time out, a 429 or 5xx status code, or an [AckError] that [AckError.IsTransient].
+// Validation errors and Request errors reported by eBay are not retryable.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var ackErr *AckError
+	if errors.As(err, &ackErr) {
+		return ackErr.IsTransient()
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return retryableStatus(apiErr.StatusCode)
+	}
+	return errors.Is(err, ErrFailedRequest)
+}
+
+// A ValidationError is returned when a param fails validation.
+// It wraps the sentinel error describing the failure, such as [ErrInvalidEntriesPerPage].
+type ValidationError struct {
+	// Err is the sentinel error describing the failure.
+	Err error
+
+	// Key is the param key that failed validation, such as paginationInput.entriesPerPage.
+	Key string
+
+	// Value is the value of the param that failed validation.
+	Value string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s=%q", e.Err, e.Key, e.Value)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// errorDetails decodes the error details from an eBay Finding API error response body.
+// eBay reports errors either in a top-level errorMessage or in the errorMessage of the
+// operation's response container. It returns nil if the body contains neither.
+func errorDetails(body io.Reader) []ErrorData {
+	data, err := io.ReadAll(io.LimitReader(body, maxErrorBodySize))
+	if err != nil {
+		return nil
+	}
+	var top struct {
+		ErrorMessage []ErrorMessage `json:"errorMessage"`
+	}
+	if err = json.Unmarshal(data, &top); err == nil && len(top.ErrorMessage) > 0 {
+		return flattenErrors(top.ErrorMessage)
+	}
+	var ops map[string][]FindItemsResponse
+	if err = json.Unmarshal(data, &ops); err != nil {
+		return nil
+	}
+	var details []ErrorData
+	for _, op := range ops {
+		for _, r := range op {
+			details = append(details, flattenErrors(r.ErrorMessage)...)
+		}
+	}
+	return details
+}
+
+// errorMessages returns the non-empty messages of details joined by semicolons.
+func errorMessages(details []ErrorData) string {
+	var msgs []string
+	for _, d := range details {
+		if len(d.Message) > 0 && d.Message[0] != "" {
+			msgs = append(msgs, d.Message[0])
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func flattenErrors(msgs []ErrorMessage) []ErrorData {
+	var details []ErrorData
+	for _, m := range msgs {
+		details = append(details, m.Error...)
+	}
+	return details
+}
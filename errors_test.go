@@ -0,0 +1,154 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestFindingClient_APIError(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name    string
+		Body    string
+		Errors  []ErrorData
+		Message string
+	}{
+		{
+			Name:   "TopLevelErrorMessage",
+			Body:   `{"errorMessage":[{"error":[{"errorId":["11002"],"message":["Authentication failed : Invalid Application: ebay-app-id"]}]}]}`,
+			Errors: []ErrorData{{ErrorID: []string{"11002"}, Message: []string{"Authentication failed : Invalid Application: ebay-app-id"}}},
+			Message: "ebay: failed to perform eBay Finding API request with status code: 500: " +
+				"Authentication failed : Invalid Application: ebay-app-id",
+		},
+		{
+			Name:    "OperationErrorMessage",
+			Body:    `{"findItemsByKeywordsResponse":[{"ack":["Failure"],"errorMessage":[{"error":[{"errorId":["3"],"message":["Invalid filter"]}]}]}]}`,
+			Errors:  []ErrorData{{ErrorID: []string{"3"}, Message: []string{"Invalid filter"}}},
+			Message: "ebay: failed to perform eBay Finding API request with status code: 500: Invalid filter",
+		},
+		{
+			Name:    "UndecodableBody",
+			Body:    `<html>Internal Server Error</html>`,
+			Message: "ebay: failed to perform eBay Finding API request with status code: 500",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				if _, err := w.Write([]byte(tc.Body)); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}))
+			defer ts.Close()
+			client := NewFindingClient(ts.Client(), "ebay-app-id")
+			client.URL = ts.URL
+			_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+			if !errors.Is(err, ErrInvalidStatus) {
+				t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrInvalidStatus)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("FindingClient.FindItemsByKeywords() error = %T, want *APIError", err)
+			}
+			if apiErr.StatusCode != http.StatusInternalServerError {
+				t.Errorf("APIError.StatusCode = %d, want %d", apiErr.StatusCode, http.StatusInternalServerError)
+			}
+			if !reflect.DeepEqual(apiErr.Errors, tc.Errors) {
+				t.Errorf("APIError.Errors = %v, want %v", apiErr.Errors, tc.Errors)
+			}
+			if got := err.Error(); got != tc.Message {
+				t.Errorf("APIError.Error() = %q, want %q", got, tc.Message)
+			}
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	t.Parallel()
+	client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+	params := map[string]string{"keywords": "testword", paramEntriesPerPage: "abc"}
+	_, err := client.GotoPage(context.Background(), OperationByKeywords, params, 1)
+	if !errors.Is(err, ErrInvalidEntriesPerPage) {
+		t.Fatalf("FindingClient.GotoPage() error = %v, want %v", err, ErrInvalidEntriesPerPage)
+	}
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("FindingClient.GotoPage() error = %T, want *ValidationError", err)
+	}
+	want := &ValidationError{Err: ErrInvalidEntriesPerPage, Key: paramEntriesPerPage, Value: "abc"}
+	if !reflect.DeepEqual(vErr, want) {
+		t.Errorf("FindingClient.GotoPage() error = %#v, want %#v", vErr, want)
+	}
+	wantMsg := `ebay: entries per page must be between 1 and 100: paginationInput.entriesPerPage="abc"`
+	if got := vErr.Error(); got != wantMsg {
+		t.Errorf("ValidationError.Error() = %q, want %q", got, wantMsg)
+	}
+}
+
+func TestAckError(t *testing.T) {
+	t.Parallel()
+	system := ErrorData{Category: []string{"System"}, Message: []string{"Internal error"}}
+	request := ErrorData{Category: []string{"Request"}, Message: []string{"Invalid keywords"}}
+	testCases := []struct {
+		Name      string
+		Err       *AckError
+		Want      error
+		Transient bool
+	}{
+		{Name: "SystemFailure", Err: &AckError{Ack: AckFailure, Errors: []ErrorData{system}}, Want: ErrAckFailure, Transient: true},
+		{Name: "RequestFailure", Err: &AckError{Ack: AckFailure, Errors: []ErrorData{request}}, Want: ErrAckFailure},
+		{Name: "MixedFailure", Err: &AckError{Ack: AckFailure, Errors: []ErrorData{system, request}}, Want: ErrAckFailure},
+		{Name: "NoDetails", Err: &AckError{Ack: AckFailure}, Want: ErrAckFailure},
+		{Name: "PartialFailure", Err: &AckError{Ack: AckPartialFailure, Errors: []ErrorData{system}}, Want: ErrPartialFailure, Transient: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if !errors.Is(tc.Err, tc.Want) {
+				t.Errorf("AckError = %v, want %v", tc.Err, tc.Want)
+			}
+			if got := tc.Err.IsTransient(); got != tc.Transient {
+				t.Errorf("AckError.IsTransient() = %t, want %t", got, tc.Transient)
+			}
+			if got := IsRetryable(tc.Err); got != tc.Transient {
+				t.Errorf("IsRetryable() = %t, want %t", got, tc.Transient)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name string
+		Err  error
+		Want bool
+	}{
+		{Name: "FailedRequest", Err: fmt.Errorf("%w: connection refused", ErrFailedRequest), Want: true},
+		{Name: "Canceled", Err: fmt.Errorf("%w: %w", ErrFailedRequest, context.Canceled)},
+		{Name: "DeadlineExceeded", Err: fmt.Errorf("%w: %w", ErrFailedRequest, context.DeadlineExceeded)},
+		{Name: "TooManyRequests", Err: &APIError{StatusCode: http.StatusTooManyRequests}, Want: true},
+		{Name: "ServerError", Err: &APIError{StatusCode: http.StatusBadGateway}, Want: true},
+		{Name: "BadRequest", Err: &APIError{StatusCode: http.StatusBadRequest}},
+		{Name: "ValidationError", Err: &ValidationError{Err: ErrInvalidPageNumber, Key: paramPageNumber}},
+		{Name: "Nil"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsRetryable(tc.Err); got != tc.Want {
+				t.Errorf("IsRetryable(%v) = %t, want %t", tc.Err, got, tc.Want)
+			}
+		})
+	}
+}
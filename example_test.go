@@ -5,6 +5,7 @@ package ebay_test
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -82,3 +83,56 @@ func ExampleFindingClient_FindItemsInEBayStores() {
 	client := ebay.NewFindingClient(c, appID)
 	_, _ = client.FindItemsInEBayStores(context.Background(), params)
 }
+
+func ExampleFindingClient_FindItems() {
+	params := map[string]string{
+		"keywords":              "iphone",
+		"itemFilter.name":       "MaxPrice",
+		"itemFilter.value":      "500.0",
+		"itemFilter.paramName":  "Currency",
+		"itemFilter.paramValue": "EUR",
+	}
+	c := &http.Client{Timeout: time.Second * 5}
+	appID := "your_app_id"
+	client := ebay.NewFindingClient(c, appID)
+	_, _ = client.FindItems(context.Background(), ebay.OperationByKeywords, params)
+}
+
+// spanObserver records each eBay Finding API request as a span, in the style of
+// otelhttp, using log/slog in place of a tracer.
+type spanObserver struct {
+	logger *slog.Logger
+}
+
+func (o spanObserver) ObserveRoundTrip(ctx context.Context, op ebay.Operation, req *http.Request) func(ebay.RoundTripInfo) {
+	// With OpenTelemetry, start a span here: ctx, span := tracer.Start(ctx, "ebay."+op).
+	o.logger.InfoContext(ctx, "span start", "name", "ebay."+op, "http.method", req.Method)
+	return func(info ebay.RoundTripInfo) {
+		// With OpenTelemetry, set the span attributes and call span.End() here.
+		o.logger.InfoContext(ctx, "span end", "name", "ebay."+op,
+			"http.status_code", info.StatusCode, "http.response_content_length", info.Bytes,
+			"duration", info.Latency, "error", info.Err)
+	}
+}
+
+func ExampleRoundTripObserver() {
+	c := &http.Client{Timeout: time.Second * 5}
+	appID := "your_app_id"
+	client := ebay.NewFindingClient(c, appID)
+	client.Middleware = []ebay.Middleware{ebay.ObserverMiddleware(spanObserver{logger: slog.Default()})}
+	_, _ = client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "iphone"})
+}
+
+func ExampleFindParams() {
+	currency, eur := "Currency", "EUR"
+	var params ebay.FindParams
+	params.Set("keywords", "iphone").
+		AddItemFilter("MaxPrice", []string{"500.0"}, &currency, &eur).
+		AddItemFilter("Condition", []string{"1000", "1500"}, nil, nil).
+		SetPagination(25, 1).
+		SetSortOrder("PricePlusShippingLowest")
+	c := &http.Client{Timeout: time.Second * 5}
+	appID := "your_app_id"
+	client := ebay.NewFindingClient(c, appID)
+	_, _ = client.FindItemsByKeywords(context.Background(), params.Map())
+}
@@ -4,25 +4,62 @@
 package ebay
 
 import (
+	"bytes"
+	"cmp"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
 	findingURL        = "https://svcs.ebay.com/services/search/FindingService/v1"
-	operationAdvanced = "findItemsAdvanced"
-	operationCategory = "findItemsByCategory"
-	operationKeywords = "findItemsByKeywords"
-	operationProduct  = "findItemsByProduct"
-	operationStores   = "findItemsIneBayStores"
 	serviceVersion    = "1.0.0"
 	responseFormat    = "JSON"
+	responseMediaType = "application/json"
 	restPayload       = ""
+	headerGlobalID    = "X-EBAY-SOA-GLOBAL-ID"
+	paramGlobalID     = "Global-ID"
+	defaultGlobalID   = "EBAY-US"
+	headerProtocol    = "X-EBAY-SOA-MESSAGE-PROTOCOL"
+	encodingGzip      = "gzip"
 )
 
+const (
+	queryOperationName  = "Operation-Name"
+	queryServiceVersion = "Service-Version"
+	queryAppName        = "Security-AppName"
+	queryResponseFormat = "Response-Data-Format"
+	queryRESTPayload    = "REST-Payload"
+)
+
+// clientQueryParams are the query parameters set by the client rather than from the params.
+var clientQueryParams = []string{
+	queryOperationName, queryServiceVersion, queryAppName, queryResponseFormat, queryRESTPayload,
+}
+
+// filterParamPrefixes are the prefixes of the params that ForceIndexedFilters rewrites.
+var filterParamPrefixes = []string{"itemFilter", "aspectFilter"}
+
+var serviceVersionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+var validGlobalIDs = []string{
+	"EBAY-AT", "EBAY-AU", "EBAY-CH", "EBAY-DE", "EBAY-ENCA", "EBAY-ES", "EBAY-FR", "EBAY-FRBE",
+	"EBAY-FRCA", "EBAY-GB", "EBAY-HK", "EBAY-IE", "EBAY-IN", "EBAY-IT", "EBAY-MOTOR", "EBAY-MY",
+	"EBAY-NL", "EBAY-NLBE", "EBAY-PH", "EBAY-PL", "EBAY-SG", "EBAY-US",
+}
+
 // A FindingClient is a client that interacts with the eBay Finding API.
 type FindingClient struct {
 	// Client is the HTTP client used to make requests to the eBay Finding API.
@@ -35,33 +72,211 @@ type FindingClient struct {
 	// See https://developer.ebay.com/api-docs/static/gs_create-the-ebay-api-keysets.html.
 	AppID string
 
+	// AppIDs, if non-empty, are eBay application IDs used in turn in place of AppID,
+	// one per request, to spread requests across their rate limits. The AppID used for
+	// a request is reported in its Security-AppName query parameter and to any RoundTripObserver.
+	// A client with more than one AppIDs must be created with NewFindingClient, which
+	// sets up the state used to take them in turn.
+	AppIDs []string
+
 	// URL specifies the eBay Finding API endpoint.
 	//
 	// URL defaults to the eBay Production API Gateway URI, but can be changed to
 	// the eBay Sandbox endpoint or localhost for testing purposes.
 	// See https://developer.ebay.com/api-docs/user-guides/static/finding-user-guide/finding-making-a-call.html#Endpoints.
 	URL string
+
+	// ServiceVersion is the eBay Finding API version sent in the Service-Version query parameter.
+	//
+	// ServiceVersion defaults to 1.0.0, but can be changed to opt in to newer versions
+	// that return additional response fields. It must be of the form major.minor.patch.
+	// If ServiceVersion is empty, 1.0.0 is used.
+	// See https://developer.ebay.com/Devzone/finding/ReleaseNotes.html.
+	ServiceVersion string
+
+	// Header contains the HTTP headers sent with every request to the eBay Finding API,
+	// such as a custom User-Agent or X-EBAY-SOA-GLOBAL-ID.
+	//
+	// Header entries are only sent as HTTP headers; they never replace the
+	// operation and authentication query parameters.
+	Header http.Header
+
+	// GlobalID is the eBay site (marketplace) to search, such as EBAY-US or EBAY-DE.
+	//
+	// When GlobalID is set, it is sent as the X-EBAY-SOA-GLOBAL-ID header on every request,
+	// which avoids repeating Global-ID in each params map. A Global-ID param still takes
+	// precedence for a single call. GlobalID must be one of the documented global IDs.
+	// See https://developer.ebay.com/Devzone/finding/CallRef/Enums/GlobalIdList.html.
+	GlobalID string
+
+	// RESTPayload is the value of the REST-Payload query parameter.
+	// If empty, the parameter is sent without a value.
+	RESTPayload string
+
+	// MessageProtocol is the value of the X-EBAY-SOA-MESSAGE-PROTOCOL header, such as SOAP12,
+	// for gateways that require it. If empty, the header is not sent.
+	MessageProtocol string
+
+	// DefaultTimeout limits the duration of each request whose context has no deadline.
+	// A zero DefaultTimeout means requests without a context deadline never time out,
+	// unless the HTTP client has its own timeout.
+	DefaultTimeout time.Duration
+
+	// AcceptGzip enables requesting gzip-compressed responses, reducing the size of large
+	// result pages. Responses with a gzip Content-Encoding are decompressed before decoding.
+	AcceptGzip bool
+
+	// TokenSource, if non-nil, is called before every request to fetch an OAuth token,
+	// which is sent as a bearer token in the Authorization header in addition to the AppID,
+	// for example for gateways behind an OAuth-protected proxy.
+	TokenSource func(ctx context.Context) (string, error)
+
+	// CallTimeout limits the duration of each call to a client method, spanning retries made
+	// by Middleware and every page or search of methods that make several requests, such as
+	// LastPage and FindItemsByKeywordsBatch. When it is exceeded, the call fails with an error
+	// wrapping both [ErrFailedRequest] and [context.DeadlineExceeded]. A zero CallTimeout means
+	// calls are limited only by their context, DefaultTimeout, and the HTTP client.
+	CallTimeout time.Duration
+
+	// OnRequest, if non-nil, is called with every request before it is sent.
+	// It must not modify the request.
+	OnRequest func(req *http.Request)
+
+	// OnResponse, if non-nil, is called with every response received and the time
+	// taken to receive it. It is not called when the request fails without a response.
+	// It must not modify the response or read its body.
+	OnResponse func(resp *http.Response, latency time.Duration)
+
+	// CaptureRawResponse enables recording the body of the most recent successful
+	// response, which is then available from LastRawResponse. It is intended for
+	// diagnosing how eBay's JSON maps onto the response types. A client that
+	// captures responses must be created with NewFindingClient.
+	CaptureRawResponse bool
+
+	// StrictDecode enables rejecting responses containing fields the response types do not
+	// define, such as to detect changes to eBay's response schema. Decoding such a response
+	// returns an error wrapping [ErrDecodeAPIResponse] that names the unknown field.
+	// By default, unknown fields are ignored.
+	StrictDecode bool
+
+	// AutoFillPriceCurrency enables setting the Currency of MaxPrice and MinPrice item filters
+	// that have no paramName to the currency of the marketplace searched, such as EUR for
+	// EBAY-DE. The marketplace is taken from the Global-ID param, then GlobalID, then EBAY-US.
+	AutoFillPriceCurrency bool
+
+	// AllowedParamPrefixes, if non-empty, restricts the params sent to eBay to those whose
+	// keys match one of the prefixes, such as itemFilter or categoryId. A key matches a prefix
+	// if it equals the prefix or continues it with an index or field, as in itemFilter(0).name
+	// or affiliate.networkId. Other params are dropped. By default, all params are sent.
+	AllowedParamPrefixes []string
+
+	// NormalizeConditionNames enables replacing Condition item filter values that are
+	// condition names eBay does not accept, such as For parts or Like New, with their
+	// condition IDs; see [ConditionIDForName]. The names eBay accepts, New, Used, and
+	// Unspecified, are sent as is, since each matches a group of condition IDs.
+	NormalizeConditionNames bool
+
+	// ForceIndexedFilters enables rewriting non-indexed filter params into the indexed form,
+	// such as itemFilter.name into itemFilter(0).name and aspectFilter.aspectName into
+	// aspectFilter(0).aspectName, for gateways that only accept indexed filters.
+	// If filters of that kind are already given with indexes, the non-indexed filter takes
+	// the index after the highest one, such as itemFilter(1).name after itemFilter(0).name.
+	ForceIndexedFilters bool
+
+	// Middleware wraps every request made to the eBay Finding API, in order.
+	// The first middleware is the outermost.
+	Middleware []Middleware
+
+	state *clientState
+}
+
+// clientState is the state a FindingClient keeps across requests. It is held by pointer
+// so that copies of a FindingClient share it.
+type clientState struct {
+	mu          sync.Mutex
+	nextAppID   atomic.Uint64
+	rawResponse []byte
 }
 
 // NewFindingClient creates a new FindingClient with the given HTTP client and valid eBay application ID.
 func NewFindingClient(client *http.Client, appID string) *FindingClient {
-	return &FindingClient{Client: client, AppID: appID, URL: findingURL}
+	return &FindingClient{
+		Client:         client,
+		AppID:          appID,
+		URL:            findingURL,
+		ServiceVersion: serviceVersion,
+		state:          &clientState{},
+	}
 }
 
 var (
+	// ErrMissingAppID is returned when the client's AppID is empty.
+	ErrMissingAppID = errors.New("ebay: missing application ID")
+
+	// ErrUninitializedClient is returned when a client that was not created with NewFindingClient
+	// sets more than one AppIDs or CaptureRawResponse, which need state kept across requests.
+	ErrUninitializedClient = errors.New("ebay: client not created with NewFindingClient")
+
 	// ErrNewRequest is returned when creating an HTTP request fails.
 	ErrNewRequest = errors.New("ebay: failed to create HTTP request")
 
-	// ErrFailedRequest is returned when the eBay Finding API request fails.
+	// ErrFailedRequest is returned when the eBay Finding API request fails. If the request
+	// fails because its context is canceled or its deadline is exceeded, the error also wraps
+	// [context.Canceled] or [context.DeadlineExceeded].
 	ErrFailedRequest = errors.New("ebay: failed to perform eBay Finding API request")
 
 	// ErrInvalidStatus is returned when the eBay Finding API request returns an invalid status code.
 	ErrInvalidStatus = errors.New("ebay: failed to perform eBay Finding API request with status code")
 
+	// ErrInvalidServiceVersion is returned when the client's ServiceVersion is not of the form major.minor.patch.
+	ErrInvalidServiceVersion = errors.New("ebay: invalid service version")
+
+	// ErrUnsupportedOperation is returned when an eBay Finding API operation is not supported.
+	ErrUnsupportedOperation = errors.New("ebay: unsupported eBay Finding API operation")
+
+	// ErrInvalidGlobalID is returned when the client's GlobalID is not a valid eBay global ID.
+	ErrInvalidGlobalID = errors.New("ebay: invalid global ID")
+
 	// ErrDecodeAPIResponse is returned when there is an error decoding the eBay Finding API response body.
 	ErrDecodeAPIResponse = errors.New("ebay: failed to decode eBay Finding API response body")
+
+	// ErrAckFailure is returned when the eBay Finding API response has a Failure ack.
+	// It is wrapped by an [AckError] holding the error details of the response.
+	ErrAckFailure = errors.New("ebay: eBay Finding API request failed")
+
+	// ErrPartialFailure is returned along with the response when the eBay Finding API response
+	// has a PartialFailure ack. The response holds the results eBay was able to return.
+	ErrPartialFailure = errors.New("ebay: eBay Finding API request partially failed")
 )
 
+// Validate reports whether the client is configured well enough to make requests.
+// It returns [ErrMissingAppID] if AppID is empty or only whitespace and AppIDs is empty,
+// or if any of AppIDs is empty or only whitespace, [ErrInvalidGlobalID]
+// if GlobalID is set to an unknown global ID, [ErrInvalidServiceVersion] if ServiceVersion
+// is set but not of the form major.minor.patch, and [ErrUninitializedClient] if the client
+// was not created with NewFindingClient but sets more than one AppIDs or CaptureRawResponse.
+// The find methods call Validate before sending any request.
+func (c *FindingClient) Validate() error {
+	if len(c.AppIDs) == 0 && strings.TrimSpace(c.AppID) == "" {
+		return ErrMissingAppID
+	}
+	for _, id := range c.AppIDs {
+		if strings.TrimSpace(id) == "" {
+			return ErrMissingAppID
+		}
+	}
+	if c.GlobalID != "" && !slices.Contains(validGlobalIDs, c.GlobalID) {
+		return fmt.Errorf("%w: %q", ErrInvalidGlobalID, c.GlobalID)
+	}
+	if c.ServiceVersion != "" && !serviceVersionPattern.MatchString(c.ServiceVersion) {
+		return fmt.Errorf("%w: %q", ErrInvalidServiceVersion, c.ServiceVersion)
+	}
+	if c.state == nil && (len(c.AppIDs) > 1 || c.CaptureRawResponse) {
+		return ErrUninitializedClient
+	}
+	return nil
+}
+
 // FindItemsAdvanced searches for items on eBay by category and/or keyword.
 // See [Searching and Browsing By Category] for searching by category
 // and [Searching by Keywords] for searching by keywords.
@@ -69,21 +284,9 @@ var (
 // [Searching and Browsing By Category]: https://developer.ebay.com/api-docs/user-guides/static/finding-user-guide/finding-searching-browsing-by-category.html
 // [Searching by Keywords]: https://developer.ebay.com/api-docs/user-guides/static/finding-user-guide/finding-searching-by-keywords.html
 func (c *FindingClient) FindItemsAdvanced(ctx context.Context, params map[string]string) (*FindItemsAdvancedResponse, error) {
-	req, err := c.request(ctx, operationAdvanced, params)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrNewRequest, err)
-	}
-	resp, err := c.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrFailedRequest, err)
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrInvalidStatus, resp.StatusCode)
-	}
 	var res FindItemsAdvancedResponse
-	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrDecodeAPIResponse, err)
+	if err := c.find(ctx, OperationAdvanced, params, &res); err != nil {
+		return partialResult(&res, err)
 	}
 	return &res, nil
 }
@@ -93,21 +296,9 @@ func (c *FindingClient) FindItemsAdvanced(ctx context.Context, params map[string
 //
 // [Searching and Browsing By Category]: https://developer.ebay.com/api-docs/user-guides/static/finding-user-guide/finding-searching-browsing-by-category.html
 func (c *FindingClient) FindItemsByCategory(ctx context.Context, params map[string]string) (*FindItemsByCategoryResponse, error) {
-	req, err := c.request(ctx, operationCategory, params)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrNewRequest, err)
-	}
-	resp, err := c.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrFailedRequest, err)
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrInvalidStatus, resp.StatusCode)
-	}
 	var res FindItemsByCategoryResponse
-	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrDecodeAPIResponse, err)
+	if err := c.find(ctx, OperationByCategory, params, &res); err != nil {
+		return partialResult(&res, err)
 	}
 	return &res, nil
 }
@@ -117,21 +308,9 @@ func (c *FindingClient) FindItemsByCategory(ctx context.Context, params map[stri
 //
 // [Searching by Keywords]: https://developer.ebay.com/api-docs/user-guides/static/finding-user-guide/finding-searching-by-keywords.html
 func (c *FindingClient) FindItemsByKeywords(ctx context.Context, params map[string]string) (*FindItemsByKeywordsResponse, error) {
-	req, err := c.request(ctx, operationKeywords, params)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrNewRequest, err)
-	}
-	resp, err := c.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrFailedRequest, err)
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrInvalidStatus, resp.StatusCode)
-	}
 	var res FindItemsByKeywordsResponse
-	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrDecodeAPIResponse, err)
+	if err := c.find(ctx, OperationByKeywords, params, &res); err != nil {
+		return partialResult(&res, err)
 	}
 	return &res, nil
 }
@@ -141,21 +320,9 @@ func (c *FindingClient) FindItemsByKeywords(ctx context.Context, params map[stri
 //
 // [Searching by Product]: https://developer.ebay.com/api-docs/user-guides/static/finding-user-guide/finding-searching-by-product.html
 func (c *FindingClient) FindItemsByProduct(ctx context.Context, params map[string]string) (*FindItemsByProductResponse, error) {
-	req, err := c.request(ctx, operationProduct, params)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrNewRequest, err)
-	}
-	resp, err := c.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrFailedRequest, err)
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrInvalidStatus, resp.StatusCode)
-	}
 	var res FindItemsByProductResponse
-	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrDecodeAPIResponse, err)
+	if err := c.find(ctx, OperationByProduct, params, &res); err != nil {
+		return partialResult(&res, err)
 	}
 	return &res, nil
 }
@@ -169,41 +336,396 @@ func (c *FindingClient) FindItemsByProduct(ctx context.Context, params map[strin
 // [Searching and Browsing By Category]: https://developer.ebay.com/api-docs/user-guides/static/finding-user-guide/finding-searching-browsing-by-category.html
 // [Searching by Keywords]: https://developer.ebay.com/api-docs/user-guides/static/finding-user-guide/finding-searching-by-keywords.html
 func (c *FindingClient) FindItemsInEBayStores(ctx context.Context, params map[string]string) (*FindItemsInEBayStoresResponse, error) {
-	req, err := c.request(ctx, operationStores, params)
+	var res FindItemsInEBayStoresResponse
+	if err := c.find(ctx, OperationInStores, params, &res); err != nil {
+		return partialResult(&res, err)
+	}
+	return &res, nil
+}
+
+// BuildRequestURL returns the URL the client would request for the eBay Finding API operation op
+// with the given params, without sending the request. BuildRequestURL performs
+// the same validation as the find methods and returns the same errors.
+func (c *FindingClient) BuildRequestURL(ctx context.Context, op Operation, params map[string]string) (string, error) {
+	req, err := c.buildRequest(ctx, op, params)
+	if err != nil {
+		return "", err
+	}
+	return req.URL.String(), nil
+}
+
+// DebugString returns the decoded query parameters the client would send for the
+// eBay Finding API operation op with the given params, one "key = value" line per
+// parameter sorted by key, such as for sharing a reproduction in a bug report.
+// The Security-AppName value is redacted, and no HTTP headers, such as an
+// Authorization token, are included. DebugString performs the same validation as
+// [FindingClient.BuildRequestURL] and returns the same errors.
+func (c *FindingClient) DebugString(op Operation, params map[string]string) (string, error) {
+	req, err := c.buildRequest(context.Background(), op, params)
+	if err != nil {
+		return "", err
+	}
+	qry := req.URL.Query()
+	if qry.Has(queryAppName) {
+		qry.Set(queryAppName, redactedAppName)
+	}
+	var b strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(qry)) {
+		for _, v := range qry[k] {
+			fmt.Fprintf(&b, "%s = %s\n", k, v)
+		}
+	}
+	return b.String(), nil
+}
+
+func (c *FindingClient) buildRequest(ctx context.Context, op Operation, params map[string]string) (*http.Request, error) {
+	if !slices.Contains(operations, op) {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedOperation, op)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	req, err := c.request(ctx, op, c.peekAppID(), params)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrNewRequest, err)
 	}
-	resp, err := c.Do(req)
+	return req, nil
+}
+
+// ParseFindingRequest returns the operation and params of req, a request built by a
+// [FindingClient], such as one captured from traffic. It is the inverse of building a
+// request: the params exclude the query parameters set by the client, such as
+// Operation-Name and Security-AppName. ParseFindingRequest returns [ErrUnsupportedOperation]
+// if the operation is not supported, and [ErrMultiValuedParam] if a param has more than one value.
+func ParseFindingRequest(req *http.Request) (Operation, map[string]string, error) {
+	qry := req.URL.Query()
+	op, err := ParseOperation(qry.Get(queryOperationName))
+	if err != nil {
+		return "", nil, err
+	}
+	for _, k := range clientQueryParams {
+		qry.Del(k)
+	}
+	params, err := ParamsFromValues(qry)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrFailedRequest, err)
+		return "", nil, err
+	}
+	return op, params, nil
+}
+
+// FindItems searches for items on eBay using the eBay Finding API operation op, which is
+// chosen at runtime. The result is the operation's response, such as
+// [FindItemsByKeywordsResponse] for [OperationByKeywords]. FindItems returns [ErrUnsupportedOperation] for unknown operations.
+func (c *FindingClient) FindItems(ctx context.Context, op Operation, params map[string]string) (ResultProvider, error) {
+	var res ResultProvider
+	switch op {
+	case OperationAdvanced:
+		res = &FindItemsAdvancedResponse{}
+	case OperationByCategory:
+		res = &FindItemsByCategoryResponse{}
+	case OperationByKeywords:
+		res = &FindItemsByKeywordsResponse{}
+	case OperationByProduct:
+		res = &FindItemsByProductResponse{}
+	case OperationInStores:
+		res = &FindItemsInEBayStoresResponse{}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedOperation, op)
+	}
+	if err := c.find(ctx, op, params, res); err != nil {
+		return partialResult(res, err)
+	}
+	return res, nil
+}
+
+func (c *FindingClient) find(ctx context.Context, op Operation, params map[string]string, v any) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if err := validateParams(params); err != nil {
+		return err
+	}
+	if _, ok := ctx.Deadline(); !ok && c.DefaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.DefaultTimeout)
+		defer cancel()
+	}
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
+	req, err := c.request(ctx, op, c.appID(), params)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNewRequest, err)
+	}
+	if c.TokenSource != nil {
+		token, err := c.TokenSource(ctx)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrNewRequest, err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if c.OnRequest != nil {
+		c.OnRequest(req)
+	}
+	start := time.Now()
+	resp, err := c.roundTrip()(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("%w: %w", ErrFailedRequest, ctxErr)
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%w: %w", ErrFailedRequest, err)
+		}
+		return fmt.Errorf("%w: %s", ErrFailedRequest, err)
+	}
+	if c.OnResponse != nil {
+		c.OnResponse(resp, time.Since(start))
 	}
 	defer resp.Body.Close()
+	body := io.Reader(resp.Body)
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), encodingGzip) {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrDecodeAPIResponse, err)
+		}
+		defer gz.Close()
+		body = gz
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrInvalidStatus, resp.StatusCode)
+		return &APIError{StatusCode: resp.StatusCode, Errors: errorDetails(body)}
 	}
-	var res FindItemsInEBayStoresResponse
-	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrDecodeAPIResponse, err)
+	if c.CaptureRawResponse {
+		var raw []byte
+		if raw, err = io.ReadAll(body); err != nil {
+			return fmt.Errorf("%w: %s", ErrDecodeAPIResponse, err)
+		}
+		if st := c.state; st != nil {
+			st.mu.Lock()
+			st.rawResponse = raw
+			st.mu.Unlock()
+		}
+		body = bytes.NewReader(raw)
 	}
-	return &res, nil
+	dec := json.NewDecoder(body)
+	if c.StrictDecode {
+		dec.DisallowUnknownFields()
+	}
+	if err = dec.Decode(v); err != nil {
+		return fmt.Errorf("%w: %s", ErrDecodeAPIResponse, err)
+	}
+	if res, ok := v.(ResultProvider); ok {
+		return checkAck(res)
+	}
+	return nil
+}
+
+// checkAck returns an [AckError] wrapping [ErrAckFailure] if any result of res has a Failure ack,
+// or [ErrPartialFailure] if any has a PartialFailure ack.
+func checkAck(res ResultProvider) error {
+	var partial error
+	for _, r := range res.Results() {
+		switch r.AckValue() {
+		case AckFailure:
+			return &AckError{Ack: AckFailure, Errors: r.Errors()}
+		case AckPartialFailure:
+			partial = &AckError{Ack: AckPartialFailure, Errors: r.Errors()}
+		}
+	}
+	return partial
+}
+
+// partialResult returns res along with err if err is [ErrPartialFailure],
+// and the zero value otherwise.
+func partialResult[T any](res T, err error) (T, error) {
+	if errors.Is(err, ErrPartialFailure) {
+		return res, err
+	}
+	var zero T
+	return zero, err
+}
+
+// LastRawResponse returns a copy of the body of the most recent successful response,
+// or nil if CaptureRawResponse is not enabled or no response has been captured.
+func (c *FindingClient) LastRawResponse() []byte {
+	st := c.state
+	if st == nil {
+		return nil
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return bytes.Clone(st.rawResponse)
 }
 
-func (c *FindingClient) request(ctx context.Context, op string, params map[string]string) (*http.Request, error) {
+// appID returns the application ID for the next request, taking AppIDs in turn if set.
+func (c *FindingClient) appID() string {
+	if len(c.AppIDs) == 0 || c.state == nil {
+		return c.peekAppID()
+	}
+	n := c.state.nextAppID.Add(1) - 1
+	return c.AppIDs[n%uint64(len(c.AppIDs))]
+}
+
+// peekAppID returns the application ID the next request would use, without taking
+// it from AppIDs, so that building a request without sending it leaves the turn unchanged.
+func (c *FindingClient) peekAppID() string {
+	if len(c.AppIDs) == 0 {
+		return c.AppID
+	}
+	if c.state == nil {
+		return c.AppIDs[0]
+	}
+	n := c.state.nextAppID.Load()
+	return c.AppIDs[n%uint64(len(c.AppIDs))]
+}
+
+// withCallTimeout returns a copy of ctx limited by c.CallTimeout, if set.
+func (c *FindingClient) withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.CallTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.CallTimeout)
+}
+
+func (c *FindingClient) request(ctx context.Context, op Operation, appID string, params map[string]string) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL, nil)
 	if err != nil {
 		return nil, err
 	}
+	for k, vs := range c.Header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", responseMediaType)
+	}
+	if c.GlobalID != "" {
+		req.Header.Set(headerGlobalID, c.GlobalID)
+	}
+	if c.MessageProtocol != "" {
+		req.Header.Set(headerProtocol, c.MessageProtocol)
+	}
+	if c.AcceptGzip {
+		req.Header.Set("Accept-Encoding", encodingGzip)
+	}
 	qry := req.URL.Query()
-	qry.Set("Operation-Name", op)
-	qry.Set("Service-Version", serviceVersion)
-	qry.Set("Security-AppName", c.AppID)
-	qry.Set("Response-Data-Format", responseFormat)
-	qry.Set("REST-Payload", restPayload)
+	qry.Set(queryOperationName, op.String())
+	version := c.ServiceVersion
+	if version == "" {
+		version = serviceVersion
+	}
+	qry.Set(queryServiceVersion, version)
+	qry.Set(queryAppName, appID)
+	qry.Set(queryResponseFormat, responseFormat)
+	payload := c.RESTPayload
+	if payload == "" {
+		payload = restPayload
+	}
+	qry.Set(queryRESTPayload, payload)
+	if c.ForceIndexedFilters {
+		params = indexedFilterParams(params)
+	}
 	for k, v := range params {
-		if v != "" {
+		if v != "" && c.allowedParam(k) {
 			qry.Set(k, v)
 		}
 	}
+	if c.AutoFillPriceCurrency {
+		for k, v := range c.priceCurrencyParams(params) {
+			if c.allowedParam(k) {
+				qry.Set(k, v)
+			}
+		}
+	}
+	if c.NormalizeConditionNames {
+		for k, v := range conditionIDParams(params) {
+			if c.allowedParam(k) {
+				qry.Set(k, v)
+			}
+		}
+	}
 	req.URL.RawQuery = qry.Encode()
 	return req, nil
 }
+
+func (c *FindingClient) allowedParam(key string) bool {
+	if len(c.AllowedParamPrefixes) == 0 {
+		return true
+	}
+	for _, p := range c.AllowedParamPrefixes {
+		rest, ok := strings.CutPrefix(key, p)
+		if ok && (rest == "" || rest[0] == '(' || rest[0] == '.') {
+			return true
+		}
+	}
+	return false
+}
+
+// indexedFilterParams returns a copy of params with the non-indexed itemFilter and
+// aspectFilter params rewritten to the indexed form. They take the index after the highest
+// index already used by filters of their kind, or index 0 if there are none, so that they
+// are never merged into or dropped in favor of an indexed filter.
+func indexedFilterParams(params map[string]string) map[string]string {
+	next := make(map[string]int)
+	for k := range params {
+		for _, f := range filterParamPrefixes {
+			if i, ok := filterIndex(k, f); ok {
+				next[f] = max(next[f], i+1)
+			}
+		}
+	}
+	m := make(map[string]string, len(params))
+	for k, v := range params {
+		for _, f := range filterParamPrefixes {
+			if rest, ok := strings.CutPrefix(k, f+"."); ok {
+				k = fmt.Sprintf("%s(%d).%s", f, next[f], rest)
+				break
+			}
+		}
+		m[k] = v
+	}
+	return m
+}
+
+// filterIndex returns the index of key if it is an indexed param of the filter kind f,
+// such as 2 for itemFilter(2).name.
+func filterIndex(key, f string) (int, bool) {
+	rest, ok := strings.CutPrefix(key, f+"(")
+	if !ok {
+		return 0, false
+	}
+	idx, field, ok := strings.Cut(rest, ")")
+	if !ok || !strings.HasPrefix(field, ".") {
+		return 0, false
+	}
+	i, err := strconv.Atoi(idx)
+	if err != nil || i < 0 {
+		return 0, false
+	}
+	return i, true
+}
+
+// priceCurrencyParams returns the Currency paramName and paramValue params for the
+// MaxPrice and MinPrice item filters in params that have no paramName.
+func (c *FindingClient) priceCurrencyParams(params map[string]string) map[string]string {
+	globalID := cmp.Or(params[paramGlobalID], c.GlobalID, defaultGlobalID)
+	mp, ok := MarketplaceByGlobalID(globalID)
+	if !ok {
+		return nil
+	}
+	m := make(map[string]string)
+	for k, v := range params {
+		prefix, ok := strings.CutSuffix(k, ".name")
+		if !ok || !strings.HasPrefix(prefix, "itemFilter") || (v != "MaxPrice" && v != "MinPrice") {
+			continue
+		}
+		if params[prefix+".paramName"] != "" {
+			continue
+		}
+		m[prefix+".paramName"] = "Currency"
+		m[prefix+".paramValue"] = mp.Currency
+	}
+	return m
+}
@@ -4,13 +4,20 @@
 package ebay
 
 import (
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestNewFindingClient(t *testing.T) {
@@ -19,15 +26,1076 @@ func TestNewFindingClient(t *testing.T) {
 	appID := "ebay-app-id"
 	got := NewFindingClient(client, appID)
 	want := &FindingClient{
-		Client: client,
-		AppID:  appID,
-		URL:    findingURL,
+		Client:         client,
+		AppID:          appID,
+		URL:            findingURL,
+		ServiceVersion: serviceVersion,
+		state:          &clientState{},
 	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("NewFindingClient() = %v, want %v", got, want)
 	}
 }
 
+func TestFindingClient_Validate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name           string
+		AppID          string
+		GlobalID       string
+		ServiceVersion string
+		Err            error
+	}{
+		{Name: "Valid", AppID: "ebay-app-id"},
+		{Name: "ValidGlobalID", AppID: "ebay-app-id", GlobalID: "EBAY-US"},
+		{Name: "ValidServiceVersion", AppID: "ebay-app-id", ServiceVersion: "1.13.0"},
+		{Name: "InvalidServiceVersion", AppID: "ebay-app-id", ServiceVersion: "1.13", Err: ErrInvalidServiceVersion},
+		{Name: "EmptyAppID", AppID: "", Err: ErrMissingAppID},
+		{Name: "WhitespaceAppID", AppID: " \t", Err: ErrMissingAppID},
+		{Name: "InvalidGlobalID", AppID: "ebay-app-id", GlobalID: "EBAY-XX", Err: ErrInvalidGlobalID},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			client := NewFindingClient(http.DefaultClient, tc.AppID)
+			client.GlobalID = tc.GlobalID
+			if tc.ServiceVersion != "" {
+				client.ServiceVersion = tc.ServiceVersion
+			}
+			if err := client.Validate(); !errors.Is(err, tc.Err) {
+				t.Errorf("FindingClient.Validate() error = %v, want %v", err, tc.Err)
+			}
+		})
+	}
+
+	t.Run("UninitializedClient", func(t *testing.T) {
+		t.Parallel()
+		clients := map[string]*FindingClient{
+			"AppIDs":             {Client: http.DefaultClient, AppIDs: []string{"app-1", "app-2"}},
+			"CaptureRawResponse": {Client: http.DefaultClient, AppID: "ebay-app-id", CaptureRawResponse: true},
+		}
+		for name, client := range clients {
+			if err := client.Validate(); !errors.Is(err, ErrUninitializedClient) {
+				t.Errorf("FindingClient.Validate() with %s error = %v, want %v", name, err, ErrUninitializedClient)
+			}
+		}
+		client := &FindingClient{Client: http.DefaultClient, AppIDs: []string{"app-1"}}
+		if err := client.Validate(); err != nil {
+			t.Errorf("FindingClient.Validate() with one AppID error = %v, want nil", err)
+		}
+	})
+
+	t.Run("FindMethodsValidate", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "")
+		client.URL = "http://localhost"
+		_, err := client.FindItemsByCategory(context.Background(), map[string]string{"categoryId": "123"})
+		if !errors.Is(err, ErrMissingAppID) {
+			t.Errorf("FindingClient.FindItemsByCategory() error = %v, want %v", err, ErrMissingAppID)
+		}
+	})
+}
+
+func TestFindingClient_BuildRequestURL(t *testing.T) {
+	t.Parallel()
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		params := map[string]string{"keywords": "iphone", "itemFilter(0).name": "MaxPrice"}
+		got, err := client.BuildRequestURL(context.Background(), OperationByKeywords, params)
+		if err != nil {
+			t.Fatalf("FindingClient.BuildRequestURL() error = %v, want nil", err)
+		}
+		want := findingURL + "?Operation-Name=findItemsByKeywords&REST-Payload=&Response-Data-Format=JSON" +
+			"&Security-AppName=ebay-app-id&Service-Version=1.0.0&itemFilter%280%29.name=MaxPrice&keywords=iphone"
+		if got != want {
+			t.Errorf("FindingClient.BuildRequestURL() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("UnsupportedOperationError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		_, err := client.BuildRequestURL(context.Background(), "findItemsEverywhere", map[string]string{})
+		if !errors.Is(err, ErrUnsupportedOperation) {
+			t.Errorf("FindingClient.BuildRequestURL() error = %v, want %v", err, ErrUnsupportedOperation)
+		}
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "")
+		_, err := client.BuildRequestURL(context.Background(), OperationByKeywords, map[string]string{})
+		if !errors.Is(err, ErrMissingAppID) {
+			t.Errorf("FindingClient.BuildRequestURL() error = %v, want %v", err, ErrMissingAppID)
+		}
+	})
+
+	t.Run("HTTPNewRequestError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.URL = "http://example.com/\x00invalid"
+		_, err := client.BuildRequestURL(context.Background(), OperationByKeywords, map[string]string{})
+		if !errors.Is(err, ErrNewRequest) {
+			t.Errorf("FindingClient.BuildRequestURL() error = %v, want %v", err, ErrNewRequest)
+		}
+	})
+}
+
+func TestFindingClient_DebugString(t *testing.T) {
+	t.Parallel()
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		params := map[string]string{"keywords": "iphone & ipad", "itemFilter(0).name": "MaxPrice"}
+		got, err := client.DebugString(OperationByKeywords, params)
+		if err != nil {
+			t.Fatalf("FindingClient.DebugString() error = %v, want nil", err)
+		}
+		want := "Operation-Name = findItemsByKeywords\n" +
+			"REST-Payload = \n" +
+			"Response-Data-Format = JSON\n" +
+			"Security-AppName = REDACTED\n" +
+			"Service-Version = 1.0.0\n" +
+			"itemFilter(0).name = MaxPrice\n" +
+			"keywords = iphone & ipad\n"
+		if got != want {
+			t.Errorf("FindingClient.DebugString() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("RedactsCredentials", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.TokenSource = func(context.Context) (string, error) { return "secret-token", nil }
+		got, err := client.DebugString(OperationByKeywords, map[string]string{"keywords": "iphone"})
+		if err != nil {
+			t.Fatalf("FindingClient.DebugString() error = %v, want nil", err)
+		}
+		if strings.Contains(got, "ebay-app-id") || strings.Contains(got, "secret-token") {
+			t.Errorf("FindingClient.DebugString() = %q, want no AppID or token", got)
+		}
+	})
+
+	t.Run("UnsupportedOperationError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		_, err := client.DebugString("findItemsEverywhere", map[string]string{})
+		if !errors.Is(err, ErrUnsupportedOperation) {
+			t.Errorf("FindingClient.DebugString() error = %v, want %v", err, ErrUnsupportedOperation)
+		}
+	})
+}
+
+func TestFindingClient_DefaultTimeout(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+	client := NewFindingClient(ts.Client(), "ebay-app-id")
+	client.URL = ts.URL
+	client.DefaultTimeout = 10 * time.Millisecond
+	_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+	if !errors.Is(err, ErrFailedRequest) {
+		t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrFailedRequest)
+	}
+}
+
+func TestFindingClient_CallTimeout(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+	client := NewFindingClient(ts.Client(), "ebay-app-id")
+	client.URL = ts.URL
+	client.CallTimeout = 50 * time.Millisecond
+	client.Middleware = []Middleware{RetryMiddleware(1000, 10*time.Millisecond)}
+	start := time.Now()
+	_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+	if !errors.Is(err, ErrFailedRequest) {
+		t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrFailedRequest)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("FindingClient.FindItemsByKeywords() took %v, want CallTimeout to stop retries", elapsed)
+	}
+}
+
+func TestFindingClient_ContextCanceled(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+	client := NewFindingClient(ts.Client(), "ebay-app-id")
+	client.URL = ts.URL
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.FindItemsByKeywords(ctx, map[string]string{"keywords": "testword"})
+	if !errors.Is(err, ErrFailedRequest) {
+		t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrFailedRequest)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestFindingClient_ServiceVersion(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name           string
+		ServiceVersion string
+		Want           string
+	}{
+		{Name: "Default", ServiceVersion: "", Want: serviceVersion},
+		{Name: "Custom", ServiceVersion: "1.13.0", Want: "1.13.0"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			client := &FindingClient{Client: http.DefaultClient, AppID: "ebay-app-id", URL: findingURL}
+			client.ServiceVersion = tc.ServiceVersion
+			got, err := client.BuildRequestURL(context.Background(), OperationByKeywords, map[string]string{})
+			if err != nil {
+				t.Fatalf("FindingClient.BuildRequestURL() error = %v, want nil", err)
+			}
+			u, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v := u.Query().Get("Service-Version"); v != tc.Want {
+				t.Errorf("Service-Version = %q, want %q", v, tc.Want)
+			}
+		})
+	}
+}
+
+func TestFindingClient_Hooks(t *testing.T) {
+	t.Parallel()
+	t.Run("Called", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		var (
+			gotURL    string
+			gotStatus int
+		)
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.OnRequest = func(req *http.Request) {
+			gotURL = req.URL.String()
+		}
+		client.OnResponse = func(resp *http.Response, latency time.Duration) {
+			gotStatus = resp.StatusCode
+			if latency <= 0 {
+				t.Errorf("OnResponse latency = %v, want > 0", latency)
+			}
+		}
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+		if u, err := url.Parse(gotURL); err != nil || u.Query().Get("keywords") != "testword" {
+			t.Errorf("OnRequest URL = %q, want keywords=testword", gotURL)
+		}
+		if gotStatus != http.StatusOK {
+			t.Errorf("OnResponse status = %d, want %d", gotStatus, http.StatusOK)
+		}
+	})
+
+	t.Run("NoResponse", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.URL = "http://localhost"
+		client.OnResponse = func(*http.Response, time.Duration) {
+			t.Error("OnResponse called for a failed request")
+		}
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrFailedRequest) {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrFailedRequest)
+		}
+	})
+}
+
+func TestFindingClient_CaptureRawResponse(t *testing.T) {
+	t.Parallel()
+	body := `{"findItemsByKeywordsResponse":[{"ack":["Success"],"version":["1.13.0"]}]}`
+	newServer := func(t *testing.T) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(body)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+	}
+	t.Run("Enabled", func(t *testing.T) {
+		t.Parallel()
+		ts := newServer(t)
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.CaptureRawResponse = true
+		got, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+		want := &FindItemsByKeywordsResponse{
+			ItemsResponse: []FindItemsResponse{{Ack: []string{"Success"}, Version: []string{"1.13.0"}}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("FindingClient.FindItemsByKeywords() = %v, want %v", got, want)
+		}
+		if raw := string(client.LastRawResponse()); raw != body {
+			t.Errorf("FindingClient.LastRawResponse() = %s, want %s", raw, body)
+		}
+		clone := *client
+		if raw := string(clone.LastRawResponse()); raw != body {
+			t.Errorf("copied FindingClient.LastRawResponse() = %s, want %s", raw, body)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		t.Parallel()
+		ts := newServer(t)
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+		if raw := client.LastRawResponse(); raw != nil {
+			t.Errorf("FindingClient.LastRawResponse() = %s, want nil", raw)
+		}
+	})
+}
+
+func TestFindingClient_StrictDecode(t *testing.T) {
+	t.Parallel()
+	body := `{"findItemsByKeywordsResponse":[{"ack":["Success"],"newField":["value"]}]}`
+	newServer := func(t *testing.T) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(body)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+	}
+	t.Run("Enabled", func(t *testing.T) {
+		t.Parallel()
+		ts := newServer(t)
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.StrictDecode = true
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrDecodeAPIResponse) {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrDecodeAPIResponse)
+		}
+		if !strings.Contains(err.Error(), "newField") {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want unknown field newField", err)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		t.Parallel()
+		ts := newServer(t)
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+	})
+}
+
+func TestFindingClient_AcceptGzip(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want gzip", got)
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+		res := FindItemsByKeywordsResponse{ItemsResponse: []FindItemsResponse{{Ack: []string{"Success"}}}}
+		if err := json.NewEncoder(gz).Encode(&res); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer ts.Close()
+	client := NewFindingClient(ts.Client(), "ebay-app-id")
+	client.URL = ts.URL
+	client.AcceptGzip = true
+	got, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+	if err != nil {
+		t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+	}
+	want := &FindItemsByKeywordsResponse{ItemsResponse: []FindItemsResponse{{Ack: []string{"Success"}}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindingClient.FindItemsByKeywords() = %v, want %v", got, want)
+	}
+}
+
+func TestFindingClient_AllowedParamPrefixes(t *testing.T) {
+	t.Parallel()
+	client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+	client.AllowedParamPrefixes = []string{"keywords", "itemFilter", "affiliate"}
+	params := map[string]string{
+		"keywords":               "testword",
+		"keywordsExtra":          "dropped",
+		"itemFilter(0).name":     "MaxPrice",
+		"itemFilter(0).value(0)": "500.0",
+		"affiliate.networkId":    "9",
+		"affiliate.trackingId":   "1234567890",
+		"categoryId":             "9355",
+		"experimental":           "dropped",
+	}
+	got, err := client.BuildRequestURL(context.Background(), OperationByKeywords, params)
+	if err != nil {
+		t.Fatalf("FindingClient.BuildRequestURL() error = %v, want nil", err)
+	}
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v, want nil", err)
+	}
+	qry := u.Query()
+	for _, k := range []string{"keywords", "itemFilter(0).name", "itemFilter(0).value(0)", "affiliate.networkId", "Security-AppName"} {
+		if !qry.Has(k) {
+			t.Errorf("FindingClient.BuildRequestURL() = %s, want param %s", got, k)
+		}
+	}
+	for _, k := range []string{"keywordsExtra", "categoryId", "experimental"} {
+		if qry.Has(k) {
+			t.Errorf("FindingClient.BuildRequestURL() = %s, want no param %s", got, k)
+		}
+	}
+}
+
+func TestFindingClient_ForceIndexedFilters(t *testing.T) {
+	t.Parallel()
+	params := map[string]string{
+		"keywords":                           "testword",
+		"itemFilter.name":                    "MaxPrice",
+		"itemFilter.value":                   "500.0",
+		"aspectFilter.aspectName":            "Brand",
+		"aspectFilter.aspectValueName":       "Apple",
+		"aspectFilter(0).aspectName":         "Color",
+		"aspectFilter(0).aspectValueName(0)": "Black",
+	}
+	testCases := []struct {
+		Name   string
+		Force  bool
+		Params map[string]string
+		Want   map[string]string
+		Absent []string
+	}{
+		{
+			Name:  "Enabled",
+			Force: true,
+			Want: map[string]string{
+				"itemFilter(0).name":                 "MaxPrice",
+				"itemFilter(0).value":                "500.0",
+				"aspectFilter(0).aspectName":         "Color",
+				"aspectFilter(0).aspectValueName(0)": "Black",
+				"aspectFilter(1).aspectName":         "Brand",
+				"aspectFilter(1).aspectValueName":    "Apple",
+			},
+			Absent: []string{
+				"itemFilter.name", "itemFilter.value", "aspectFilter.aspectName",
+				"aspectFilter.aspectValueName", "aspectFilter(0).aspectValueName",
+			},
+		},
+		{
+			Name:  "MixedForms",
+			Force: true,
+			Params: map[string]string{
+				"keywords":               "testword",
+				"itemFilter.name":        "MaxPrice",
+				"itemFilter.value":       "5",
+				"itemFilter(0).name":     "Condition",
+				"itemFilter(0).value(0)": "New",
+			},
+			Want: map[string]string{
+				"itemFilter(0).name":     "Condition",
+				"itemFilter(0).value(0)": "New",
+				"itemFilter(1).name":     "MaxPrice",
+				"itemFilter(1).value":    "5",
+			},
+			Absent: []string{"itemFilter.name", "itemFilter.value", "itemFilter(0).value"},
+		},
+		{
+			Name: "Disabled",
+			Want: map[string]string{
+				"itemFilter.name":                    "MaxPrice",
+				"itemFilter.value":                   "500.0",
+				"aspectFilter.aspectName":            "Brand",
+				"aspectFilter.aspectValueName":       "Apple",
+				"aspectFilter(0).aspectName":         "Color",
+				"aspectFilter(0).aspectValueName(0)": "Black",
+			},
+			Absent: []string{"itemFilter(0).name", "itemFilter(0).value"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+			client.ForceIndexedFilters = tc.Force
+			p := params
+			if tc.Params != nil {
+				p = tc.Params
+			}
+			got, err := client.BuildRequestURL(context.Background(), OperationByKeywords, p)
+			if err != nil {
+				t.Fatalf("FindingClient.BuildRequestURL() error = %v, want nil", err)
+			}
+			u, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("url.Parse() error = %v, want nil", err)
+			}
+			qry := u.Query()
+			for k, v := range tc.Want {
+				if qry.Get(k) != v {
+					t.Errorf("FindingClient.BuildRequestURL() = %s, want %s=%s", got, k, v)
+				}
+			}
+			for _, k := range tc.Absent {
+				if qry.Has(k) {
+					t.Errorf("FindingClient.BuildRequestURL() = %s, want no param %s", got, k)
+				}
+			}
+		})
+	}
+}
+
+func TestFindingClient_RESTPayloadAndMessageProtocol(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name            string
+		RESTPayload     string
+		MessageProtocol string
+		WantPayload     string
+	}{
+		{Name: "Default", WantPayload: ""},
+		{Name: "Set", RESTPayload: "true", MessageProtocol: "SOAP12", WantPayload: "true"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				qry := r.URL.Query()
+				if !qry.Has("REST-Payload") {
+					t.Error("REST-Payload query parameter is missing")
+				}
+				if got := qry.Get("REST-Payload"); got != tc.WantPayload {
+					t.Errorf("REST-Payload = %q, want %q", got, tc.WantPayload)
+				}
+				got, ok := r.Header[http.CanonicalHeaderKey("X-EBAY-SOA-MESSAGE-PROTOCOL")]
+				if tc.MessageProtocol == "" && ok {
+					t.Errorf("X-EBAY-SOA-MESSAGE-PROTOCOL = %q, want unset", got)
+				}
+				if tc.MessageProtocol != "" && (len(got) != 1 || got[0] != tc.MessageProtocol) {
+					t.Errorf("X-EBAY-SOA-MESSAGE-PROTOCOL = %q, want %q", got, tc.MessageProtocol)
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{}); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}))
+			defer ts.Close()
+			client := NewFindingClient(ts.Client(), "ebay-app-id")
+			client.URL = ts.URL
+			client.RESTPayload = tc.RESTPayload
+			client.MessageProtocol = tc.MessageProtocol
+			_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+			if err != nil {
+				t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestParseFindingRequest(t *testing.T) {
+	t.Parallel()
+	t.Run("RoundTrip", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		params := map[string]string{
+			"keywords":               "testword",
+			"itemFilter(0).name":     "MaxPrice",
+			"itemFilter(0).value(0)": "500.0",
+		}
+		u, err := client.BuildRequestURL(context.Background(), OperationAdvanced, params)
+		if err != nil {
+			t.Fatalf("FindingClient.BuildRequestURL() error = %v, want nil", err)
+		}
+		req := httptest.NewRequest(http.MethodGet, u, nil)
+		op, got, err := ParseFindingRequest(req)
+		if err != nil {
+			t.Fatalf("ParseFindingRequest() error = %v, want nil", err)
+		}
+		if op != OperationAdvanced {
+			t.Errorf("ParseFindingRequest() op = %q, want %q", op, OperationAdvanced)
+		}
+		if !reflect.DeepEqual(got, params) {
+			t.Errorf("ParseFindingRequest() params = %v, want %v", got, params)
+		}
+	})
+
+	t.Run("UnsupportedOperationError", func(t *testing.T) {
+		t.Parallel()
+		req := httptest.NewRequest(http.MethodGet, "/?Operation-Name=findItemsEverywhere&keywords=testword", nil)
+		_, _, err := ParseFindingRequest(req)
+		if !errors.Is(err, ErrUnsupportedOperation) {
+			t.Errorf("ParseFindingRequest() error = %v, want %v", err, ErrUnsupportedOperation)
+		}
+	})
+}
+
+func TestFindingClient_AutoFillPriceCurrency(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name     string
+		GlobalID string
+		Params   map[string]string
+		Want     map[string]string
+	}{
+		{
+			Name:     "GermanMarketplace",
+			GlobalID: "EBAY-DE",
+			Params: map[string]string{
+				"itemFilter(0).name":     "MaxPrice",
+				"itemFilter(0).value(0)": "500.0",
+				"itemFilter(1).name":     "MinPrice",
+				"itemFilter(1).value(0)": "100.0",
+			},
+			Want: map[string]string{
+				"itemFilter(0).paramName":  "Currency",
+				"itemFilter(0).paramValue": "EUR",
+				"itemFilter(1).paramName":  "Currency",
+				"itemFilter(1).paramValue": "EUR",
+			},
+		},
+		{
+			Name:   "DefaultMarketplace",
+			Params: map[string]string{"itemFilter.name": "MaxPrice", "itemFilter.value": "500.0"},
+			Want:   map[string]string{"itemFilter.paramName": "Currency", "itemFilter.paramValue": "USD"},
+		},
+		{
+			Name:     "GlobalIDParam",
+			GlobalID: "EBAY-GB",
+			Params: map[string]string{
+				"Global-ID":              "EBAY-DE",
+				"itemFilter(0).name":     "MaxPrice",
+				"itemFilter(0).value(0)": "500.0",
+			},
+			Want: map[string]string{"itemFilter(0).paramName": "Currency", "itemFilter(0).paramValue": "EUR"},
+		},
+		{
+			Name:     "ExplicitCurrency",
+			GlobalID: "EBAY-DE",
+			Params: map[string]string{
+				"itemFilter(0).name":       "MaxPrice",
+				"itemFilter(0).value(0)":   "500.0",
+				"itemFilter(0).paramName":  "Currency",
+				"itemFilter(0).paramValue": "GBP",
+			},
+			Want: map[string]string{"itemFilter(0).paramName": "Currency", "itemFilter(0).paramValue": "GBP"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+			client.GlobalID = tc.GlobalID
+			client.AutoFillPriceCurrency = true
+			got, err := client.BuildRequestURL(context.Background(), OperationByKeywords, tc.Params)
+			if err != nil {
+				t.Fatalf("FindingClient.BuildRequestURL() error = %v, want nil", err)
+			}
+			u, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for k, v := range tc.Want {
+				if q := u.Query().Get(k); q != v {
+					t.Errorf("%s = %q, want %q", k, q, v)
+				}
+			}
+		})
+	}
+}
+
+func TestFindingClient_Ack(t *testing.T) {
+	t.Parallel()
+	partial, err := os.ReadFile(filepath.Join("testdata", "partial_failure_response.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	newServer := func(t *testing.T, body []byte) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(body); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+	}
+	t.Run("PartialFailure", func(t *testing.T) {
+		t.Parallel()
+		ts := newServer(t, partial)
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		got, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrPartialFailure) {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrPartialFailure)
+		}
+		if got == nil || got.ItemsResponse[0].ItemCount() != 1 {
+			t.Errorf("FindingClient.FindItemsByKeywords() = %v, want partial results", got)
+		}
+		res, err := client.FindItems(context.Background(), OperationByKeywords, map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrPartialFailure) {
+			t.Errorf("FindingClient.FindItems() error = %v, want %v", err, ErrPartialFailure)
+		}
+		if res == nil {
+			t.Error("FindingClient.FindItems() = nil, want partial results")
+		}
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		body := `{"findItemsByKeywordsResponse":[{"ack":["Failure"],"errorMessage":[{"error":[{"message":["Invalid keywords"],"severity":["Error"]}]}]}]}`
+		ts := newServer(t, []byte(body))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		got, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrAckFailure) {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrAckFailure)
+		}
+		if got != nil {
+			t.Errorf("FindingClient.FindItemsByKeywords() = %v, want nil", got)
+		}
+		if want := "ebay: eBay Finding API request failed: Invalid keywords"; err.Error() != want {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %q, want %q", err, want)
+		}
+		var ackErr *AckError
+		if !errors.As(err, &ackErr) || len(ackErr.Errors) != 1 || ackErr.Errors[0].Message[0] != "Invalid keywords" {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %#v, want *AckError with the error details", err)
+		}
+	})
+}
+
+func TestFindingClient_TokenSource(t *testing.T) {
+	t.Parallel()
+	t.Run("BearerToken", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+				t.Errorf("Authorization = %q, want %q", got, "Bearer test-token")
+			}
+			if got := r.URL.Query().Get("Security-AppName"); got != "ebay-app-id" {
+				t.Errorf("Security-AppName = %q, want %q", got, "ebay-app-id")
+			}
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.TokenSource = func(context.Context) (string, error) { return "test-token", nil }
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("TokenError", func(t *testing.T) {
+		t.Parallel()
+		errToken := errors.New("token unavailable")
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.URL = "http://localhost"
+		client.TokenSource = func(context.Context) (string, error) { return "", errToken }
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrNewRequest) || !errors.Is(err, errToken) {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v and %v", err, ErrNewRequest, errToken)
+		}
+	})
+}
+
+func TestFindingClient_AppIDs(t *testing.T) {
+	t.Parallel()
+	t.Run("RoundRobin", func(t *testing.T) {
+		t.Parallel()
+		var (
+			mu  sync.Mutex
+			got []string
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			got = append(got, r.URL.Query().Get("Security-AppName"))
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "")
+		client.URL = ts.URL
+		client.AppIDs = []string{"app-1", "app-2", "app-3"}
+		var observed []string
+		client.Middleware = []Middleware{ObserverMiddleware(observerFunc(func(context.Context, Operation, *http.Request) func(RoundTripInfo) {
+			return func(info RoundTripInfo) {
+				observed = append(observed, info.AppID)
+			}
+		}))}
+		for range 6 {
+			_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+			if err != nil {
+				t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+			}
+		}
+		want := []string{"app-1", "app-2", "app-3", "app-1", "app-2", "app-3"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Security-AppName = %v, want %v", got, want)
+		}
+		if !reflect.DeepEqual(observed, want) {
+			t.Errorf("RoundTripInfo.AppID = %v, want %v", observed, want)
+		}
+	})
+
+	t.Run("DryRunKeepsTurn", func(t *testing.T) {
+		t.Parallel()
+		var got []string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = append(got, r.URL.Query().Get("Security-AppName"))
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "")
+		client.URL = ts.URL
+		client.AppIDs = []string{"app-1", "app-2", "app-3"}
+		params := map[string]string{"keywords": "testword"}
+		for range 2 {
+			u, err := client.BuildRequestURL(context.Background(), OperationByKeywords, params)
+			if err != nil {
+				t.Fatalf("FindingClient.BuildRequestURL() error = %v, want nil", err)
+			}
+			if !strings.Contains(u, "Security-AppName=app-1") {
+				t.Errorf("FindingClient.BuildRequestURL() = %s, want Security-AppName=app-1", u)
+			}
+			if _, err := client.DebugString(OperationByKeywords, params); err != nil {
+				t.Fatalf("FindingClient.DebugString() error = %v, want nil", err)
+			}
+		}
+		for range 2 {
+			if _, err := client.FindItemsByKeywords(context.Background(), params); err != nil {
+				t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+			}
+		}
+		if want := []string{"app-1", "app-2"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Security-AppName = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("MissingAppIDError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "")
+		client.AppIDs = []string{"app-1", " "}
+		if err := client.Validate(); !errors.Is(err, ErrMissingAppID) {
+			t.Errorf("FindingClient.Validate() error = %v, want %v", err, ErrMissingAppID)
+		}
+	})
+}
+
+func TestFindingClient_Header(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "ebay-test-agent" {
+			t.Errorf("User-Agent = %q, want %q", got, "ebay-test-agent")
+		}
+		if got := r.URL.Query().Get("Security-AppName"); got != "ebay-app-id" {
+			t.Errorf("Security-AppName = %q, want %q", got, "ebay-app-id")
+		}
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}))
+	defer ts.Close()
+	client := NewFindingClient(ts.Client(), "ebay-app-id")
+	client.URL = ts.URL
+	client.Header = http.Header{"User-Agent": {"ebay-test-agent"}, "Security-Appname": {"other-app-id"}}
+	_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+	if err != nil {
+		t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+	}
+}
+
+func TestFindingClient_Accept(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name   string
+		Header http.Header
+		Want   string
+	}{
+		{Name: "Default", Want: "application/json"},
+		{Name: "Override", Header: http.Header{"Accept": {"application/vnd.ebay+json"}}, Want: "application/vnd.ebay+json"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Values("Accept"); len(got) != 1 || got[0] != tc.Want {
+					t.Errorf("Accept = %q, want %q", got, tc.Want)
+				}
+				if got := r.URL.Query().Get("Response-Data-Format"); got != "JSON" {
+					t.Errorf("Response-Data-Format = %q, want JSON", got)
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{}); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}))
+			defer ts.Close()
+			client := NewFindingClient(ts.Client(), "ebay-app-id")
+			client.URL = ts.URL
+			client.Header = tc.Header
+			_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+			if err != nil {
+				t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestFindingClient_GlobalID(t *testing.T) {
+	t.Parallel()
+	t.Run("HeaderSent", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get(headerGlobalID); got != "EBAY-DE" {
+				t.Errorf("%s = %q, want %q", headerGlobalID, got, "EBAY-DE")
+			}
+			if got := r.URL.Query().Get("Global-ID"); got != "EBAY-GB" {
+				t.Errorf("Global-ID = %q, want %q", got, "EBAY-GB")
+			}
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.GlobalID = "EBAY-DE"
+		params := map[string]string{"keywords": "testword", "Global-ID": "EBAY-GB"}
+		_, err := client.FindItemsByKeywords(context.Background(), params)
+		if err != nil {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("InvalidGlobalIDError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.URL = "http://localhost"
+		client.GlobalID = "EBAY-XX"
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrInvalidGlobalID) {
+			t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrInvalidGlobalID)
+		}
+	})
+}
+
+func TestFindingClient_FindItems(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Op   Operation
+		Want ResultProvider
+	}{
+		{Op: OperationAdvanced, Want: &FindItemsAdvancedResponse{}},
+		{Op: OperationByCategory, Want: &FindItemsByCategoryResponse{}},
+		{Op: OperationByKeywords, Want: &FindItemsByKeywordsResponse{}},
+		{Op: OperationByProduct, Want: &FindItemsByProductResponse{}},
+		{Op: OperationInStores, Want: &FindItemsInEBayStoresResponse{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Op.String(), func(t *testing.T) {
+			t.Parallel()
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("Operation-Name"); got != tc.Op.String() {
+					t.Errorf("Operation-Name = %q, want %q", got, tc.Op)
+				}
+				w.WriteHeader(http.StatusOK)
+				err := json.NewEncoder(w).Encode(tc.Want)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}))
+			defer ts.Close()
+			client := NewFindingClient(ts.Client(), "ebay-app-id")
+			client.URL = ts.URL
+			got, err := client.FindItems(context.Background(), tc.Op, map[string]string{"keywords": "testword"})
+			if err != nil {
+				t.Fatalf("FindingClient.FindItems() error = %v, want nil", err)
+			}
+			if !reflect.DeepEqual(got, tc.Want) {
+				t.Errorf("FindingClient.FindItems() = %v, want %v", got, tc.Want)
+			}
+		})
+	}
+
+	t.Run("UnsupportedOperationError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		got, err := client.FindItems(context.Background(), "findItemsEverywhere", map[string]string{})
+		if !errors.Is(err, ErrUnsupportedOperation) {
+			t.Errorf("FindingClient.FindItems() error = %v, want %v", err, ErrUnsupportedOperation)
+		}
+		if got != nil {
+			t.Errorf("FindingClient.FindItems() = %v, want nil", got)
+		}
+	})
+
+	t.Run("ClientDoError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.URL = "http://localhost"
+		got, err := client.FindItems(context.Background(), OperationByKeywords, map[string]string{})
+		if !errors.Is(err, ErrFailedRequest) {
+			t.Errorf("FindingClient.FindItems() error = %v, want %v", err, ErrFailedRequest)
+		}
+		if got != nil {
+			t.Errorf("FindingClient.FindItems() = %v, want nil", got)
+		}
+	})
+}
+
 func TestFindingClient_FindItemsAdvanced(t *testing.T) {
 	t.Parallel()
 	t.Run("ResponseSuccess", func(t *testing.T) {
@@ -422,3 +1490,22 @@ func TestFindingClient_FindItemsInEBayStores(t *testing.T) {
 		}
 	})
 }
+
+func TestFindingClient_IndexedKeywords(t *testing.T) {
+	t.Parallel()
+	client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+	client.URL = "http://localhost"
+	params := map[string]string{"keywords(0)": "foo"}
+	_, err := client.FindItemsByKeywords(context.Background(), params)
+	if !errors.Is(err, ErrIndexedKeywords) {
+		t.Errorf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrIndexedKeywords)
+	}
+	_, err = client.BuildRequestURL(context.Background(), OperationByKeywords, params)
+	if !errors.Is(err, ErrIndexedKeywords) {
+		t.Errorf("FindingClient.BuildRequestURL() error = %v, want %v", err, ErrIndexedKeywords)
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Key != "keywords(0)" {
+		t.Errorf("FindingClient.BuildRequestURL() error = %v, want ValidationError for keywords(0)", err)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+// FlatSearchItem is a [SearchItem] with each single-valued field collapsed from the
+// one-element array eBay returns into the element itself. A field eBay did not return
+// is the zero value of its type.
+//
+// The nested containers, such as Condition and SellingStatus, are the first element of
+// the corresponding SearchItem field and keep their own array fields. PaymentMethod and
+// GalleryInfoContainer remain slices, as eBay returns one element for each accepted
+// payment method and each gallery image size.
+type FlatSearchItem struct {
+	AutoPay                 string
+	CharityID               string
+	Compatibility           string
+	Condition               Condition
+	Country                 string
+	DiscountPriceInfo       DiscountPriceInfo
+	Distance                Distance
+	EBayPlusEnabled         string
+	EekStatus               string
+	GalleryInfoContainer    []GalleryURL
+	GalleryPlusPictureURL   string
+	GalleryURL              string
+	GlobalID                string
+	IsMultiVariationListing string
+	ItemID                  string
+	ListingInfo             ListingInfo
+	Location                string
+	PaymentMethod           []string
+	PictureURLLarge         string
+	PictureURLSuperSize     string
+	PostalCode              string
+	PrimaryCategory         Category
+	ProductID               ProductID
+	ReturnsAccepted         string
+	SecondaryCategory       Category
+	SellerInfo              SellerInfo
+	SellingStatus           SellingStatus
+	ShippingInfo            ShippingInfo
+	StoreInfo               Storefront
+	Subtitle                string
+	Title                   string
+	TopRatedListing         string
+	UnitPrice               UnitPriceInfo
+	ViewItemURL             string
+}
+
+// Flatten returns i as a [FlatSearchItem], taking the first element of each single-valued field.
+func (i SearchItem) Flatten() FlatSearchItem {
+	return FlatSearchItem{
+		AutoPay:                 first(i.AutoPay),
+		CharityID:               first(i.CharityID),
+		Compatibility:           first(i.Compatibility),
+		Condition:               first(i.Condition),
+		Country:                 first(i.Country),
+		DiscountPriceInfo:       first(i.DiscountPriceInfo),
+		Distance:                first(i.Distance),
+		EBayPlusEnabled:         first(i.EBayPlusEnabled),
+		EekStatus:               first(i.EekStatus),
+		GalleryInfoContainer:    i.GalleryInfoContainer,
+		GalleryPlusPictureURL:   first(i.GalleryPlusPictureURL),
+		GalleryURL:              first(i.GalleryURL),
+		GlobalID:                first(i.GlobalID),
+		IsMultiVariationListing: first(i.IsMultiVariationListing),
+		ItemID:                  first(i.ItemID),
+		ListingInfo:             first(i.ListingInfo),
+		Location:                first(i.Location),
+		PaymentMethod:           i.PaymentMethod,
+		PictureURLLarge:         first(i.PictureURLLarge),
+		PictureURLSuperSize:     first(i.PictureURLSuperSize),
+		PostalCode:              first(i.PostalCode),
+		PrimaryCategory:         first(i.PrimaryCategory),
+		ProductID:               first(i.ProductID),
+		ReturnsAccepted:         first(i.ReturnsAccepted),
+		SecondaryCategory:       first(i.SecondaryCategory),
+		SellerInfo:              first(i.SellerInfo),
+		SellingStatus:           first(i.SellingStatus),
+		ShippingInfo:            first(i.ShippingInfo),
+		StoreInfo:               first(i.StoreInfo),
+		Subtitle:                first(i.Subtitle),
+		Title:                   first(i.Title),
+		TopRatedListing:         first(i.TopRatedListing),
+		UnitPrice:               first(i.UnitPrice),
+		ViewItemURL:             first(i.ViewItemURL),
+	}
+}
+
+// first returns the first element of s, or the zero value if s is empty.
+func first[T any](s []T) T {
+	var v T
+	if len(s) > 0 {
+		v = s[0]
+	}
+	return v
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchItem_Flatten(t *testing.T) {
+	t.Parallel()
+	item := SearchItem{
+		ItemID:               []string{"123"},
+		Title:                []string{"iPhone"},
+		Country:              []string{"US"},
+		Condition:            []Condition{{ConditionID: []string{"1000"}}},
+		SellingStatus:        []SellingStatus{{CurrentPrice: []Price{{CurrencyID: "USD", Value: "500.0"}}}},
+		PaymentMethod:        []string{"PayPal", "CreditCard"},
+		GalleryInfoContainer: []GalleryURL{{GallerySize: "Small"}, {GallerySize: "Large"}},
+	}
+	want := FlatSearchItem{
+		ItemID:               "123",
+		Title:                "iPhone",
+		Country:              "US",
+		Condition:            Condition{ConditionID: []string{"1000"}},
+		SellingStatus:        SellingStatus{CurrentPrice: []Price{{CurrencyID: "USD", Value: "500.0"}}},
+		PaymentMethod:        []string{"PayPal", "CreditCard"},
+		GalleryInfoContainer: []GalleryURL{{GallerySize: "Small"}, {GallerySize: "Large"}},
+	}
+	if got := item.Flatten(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SearchItem.Flatten() = %+v, want %+v", got, want)
+	}
+	if got := (SearchItem{}).Flatten(); !reflect.DeepEqual(got, FlatSearchItem{}) {
+		t.Errorf("SearchItem{}.Flatten() = %+v, want zero value", got)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testutil provides HTTP transports for recording eBay Finding API responses to
+// golden files and replaying them in tests, so that response decoding can be tested
+// against real eBay payloads without network access.
+package testutil
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Recorder is an [http.RoundTripper] that sends requests using Transport and writes the
+// body of each successful response to the golden file at Path, replacing its contents.
+// The response is returned to the caller unchanged.
+type Recorder struct {
+	// Transport sends the requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// Path is the golden file the response body is written to.
+	Path string
+}
+
+// RoundTrip implements [http.RoundTripper].
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("testutil: reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0o755); err != nil {
+		return nil, fmt.Errorf("testutil: %w", err)
+	}
+	if err := os.WriteFile(r.Path, body, 0o644); err != nil {
+		return nil, fmt.Errorf("testutil: %w", err)
+	}
+	return resp, nil
+}
+
+// Replayer is an [http.RoundTripper] that responds to every request with a 200 OK
+// JSON response whose body is the golden file at Path, without sending the request.
+type Replayer struct {
+	// Path is the golden file served as the response body.
+	Path string
+}
+
+// RoundTrip implements [http.RoundTripper].
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := os.ReadFile(r.Path)
+	if err != nil {
+		return nil, fmt.Errorf("testutil: %w", err)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// GoldenPath returns the path of the golden file for the eBay Finding API operation op,
+// such as findItemsByKeywords, in the testdata directory dir.
+func GoldenPath(dir, op string) string {
+	return filepath.Join(dir, "golden", op+".json")
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package testutil
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	const body = `{"findItemsByKeywordsResponse":[{"ack":["Success"]}]}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, body); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer ts.Close()
+	path := GoldenPath(t.TempDir(), "findItemsByKeywords")
+	if want := "findItemsByKeywords.json"; filepath.Base(path) != want {
+		t.Errorf("GoldenPath() = %s, want base %s", path, want)
+	}
+	client := &http.Client{Transport: &Recorder{Transport: ts.Client().Transport, Path: path}}
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("Recorder.RoundTrip() error = %v, want nil", err)
+	}
+	got, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != body {
+		t.Errorf("Recorder.RoundTrip() body = %s, want %s", got, body)
+	}
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(golden) != body {
+		t.Errorf("golden file = %s, want %s", golden, body)
+	}
+
+	client = &http.Client{Transport: &Replayer{Path: path}}
+	resp, err = client.Get("http://localhost")
+	if err != nil {
+		t.Fatalf("Replayer.RoundTrip() error = %v, want nil", err)
+	}
+	got, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || string(got) != body {
+		t.Errorf("Replayer.RoundTrip() = %d %s, want 200 %s", resp.StatusCode, got, body)
+	}
+}
+
+func TestRecorder_NonOKStatus(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+	path := GoldenPath(t.TempDir(), "findItemsByKeywords")
+	client := &http.Client{Transport: &Recorder{Transport: ts.Client().Transport, Path: path}}
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("Recorder.RoundTrip() error = %v, want nil", err)
+	}
+	resp.Body.Close()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("golden file written for status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+// Marketplace represents the site defaults of an eBay marketplace.
+// See https://developer.ebay.com/Devzone/finding/CallRef/Enums/GlobalIdList.html.
+type Marketplace struct {
+	// GlobalID is the eBay global ID of the marketplace, such as EBAY-DE.
+	GlobalID string
+
+	// Currency is the ISO 4217 code of the marketplace's default currency, such as EUR.
+	Currency string
+
+	// Country is the ISO 3166-1 alpha-2 code of the marketplace's country, such as DE.
+	Country string
+
+	// SiteDomain is the domain of the marketplace's eBay site, such as ebay.de.
+	SiteDomain string
+}
+
+var marketplaces = map[string]Marketplace{
+	"EBAY-AT":    {GlobalID: "EBAY-AT", Currency: "EUR", Country: "AT", SiteDomain: "ebay.at"},
+	"EBAY-AU":    {GlobalID: "EBAY-AU", Currency: "AUD", Country: "AU", SiteDomain: "ebay.com.au"},
+	"EBAY-CH":    {GlobalID: "EBAY-CH", Currency: "CHF", Country: "CH", SiteDomain: "ebay.ch"},
+	"EBAY-DE":    {GlobalID: "EBAY-DE", Currency: "EUR", Country: "DE", SiteDomain: "ebay.de"},
+	"EBAY-ENCA":  {GlobalID: "EBAY-ENCA", Currency: "CAD", Country: "CA", SiteDomain: "ebay.ca"},
+	"EBAY-ES":    {GlobalID: "EBAY-ES", Currency: "EUR", Country: "ES", SiteDomain: "ebay.es"},
+	"EBAY-FR":    {GlobalID: "EBAY-FR", Currency: "EUR", Country: "FR", SiteDomain: "ebay.fr"},
+	"EBAY-FRBE":  {GlobalID: "EBAY-FRBE", Currency: "EUR", Country: "BE", SiteDomain: "befr.ebay.be"},
+	"EBAY-FRCA":  {GlobalID: "EBAY-FRCA", Currency: "CAD", Country: "CA", SiteDomain: "cafr.ebay.ca"},
+	"EBAY-GB":    {GlobalID: "EBAY-GB", Currency: "GBP", Country: "GB", SiteDomain: "ebay.co.uk"},
+	"EBAY-HK":    {GlobalID: "EBAY-HK", Currency: "HKD", Country: "HK", SiteDomain: "ebay.com.hk"},
+	"EBAY-IE":    {GlobalID: "EBAY-IE", Currency: "EUR", Country: "IE", SiteDomain: "ebay.ie"},
+	"EBAY-IN":    {GlobalID: "EBAY-IN", Currency: "INR", Country: "IN", SiteDomain: "ebay.in"},
+	"EBAY-IT":    {GlobalID: "EBAY-IT", Currency: "EUR", Country: "IT", SiteDomain: "ebay.it"},
+	"EBAY-MOTOR": {GlobalID: "EBAY-MOTOR", Currency: "USD", Country: "US", SiteDomain: "ebay.com"},
+	"EBAY-MY":    {GlobalID: "EBAY-MY", Currency: "MYR", Country: "MY", SiteDomain: "ebay.com.my"},
+	"EBAY-NL":    {GlobalID: "EBAY-NL", Currency: "EUR", Country: "NL", SiteDomain: "ebay.nl"},
+	"EBAY-NLBE":  {GlobalID: "EBAY-NLBE", Currency: "EUR", Country: "BE", SiteDomain: "benl.ebay.be"},
+	"EBAY-PH":    {GlobalID: "EBAY-PH", Currency: "PHP", Country: "PH", SiteDomain: "ebay.ph"},
+	"EBAY-PL":    {GlobalID: "EBAY-PL", Currency: "PLN", Country: "PL", SiteDomain: "ebay.pl"},
+	"EBAY-SG":    {GlobalID: "EBAY-SG", Currency: "SGD", Country: "SG", SiteDomain: "ebay.com.sg"},
+	"EBAY-US":    {GlobalID: "EBAY-US", Currency: "USD", Country: "US", SiteDomain: "ebay.com"},
+}
+
+// MarketplaceByGlobalID returns the site defaults of the eBay marketplace with the given global ID.
+// The boolean result reports whether the global ID is known.
+func MarketplaceByGlobalID(globalID string) (Marketplace, bool) {
+	m, ok := marketplaces[globalID]
+	return m, ok
+}
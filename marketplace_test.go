@@ -0,0 +1,37 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import "testing"
+
+func TestMarketplaceByGlobalID(t *testing.T) {
+	t.Parallel()
+	t.Run("Known", func(t *testing.T) {
+		t.Parallel()
+		got, ok := MarketplaceByGlobalID("EBAY-DE")
+		want := Marketplace{GlobalID: "EBAY-DE", Currency: "EUR", Country: "DE", SiteDomain: "ebay.de"}
+		if !ok || got != want {
+			t.Errorf("MarketplaceByGlobalID(EBAY-DE) = %v, %t, want %v, true", got, ok, want)
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		t.Parallel()
+		if got, ok := MarketplaceByGlobalID("EBAY-XX"); ok {
+			t.Errorf("MarketplaceByGlobalID(EBAY-XX) = %v, true, want false", got)
+		}
+	})
+
+	t.Run("CoversValidGlobalIDs", func(t *testing.T) {
+		t.Parallel()
+		for _, id := range validGlobalIDs {
+			if m, ok := MarketplaceByGlobalID(id); !ok || m.GlobalID != id {
+				t.Errorf("MarketplaceByGlobalID(%s) = %v, %t, want marketplace for %s", id, m, ok, id)
+			}
+		}
+		if len(marketplaces) != len(validGlobalIDs) {
+			t.Errorf("len(marketplaces) = %d, want %d", len(marketplaces), len(validGlobalIDs))
+		}
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RoundTripFunc performs a single HTTP request to the eBay Finding API.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc to add behavior, such as logging or retries,
+// around the requests a [FindingClient] makes. A Middleware may short-circuit
+// the chain by returning a response or error without calling next.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// roundTrip returns the client's HTTP request execution wrapped in its middleware.
+// The first middleware is the outermost, so it runs first.
+func (c *FindingClient) roundTrip() RoundTripFunc {
+	rt := RoundTripFunc(c.Do)
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		rt = c.Middleware[i](rt)
+	}
+	return rt
+}
+
+// LoggingMiddleware returns a Middleware that logs every request URL along with
+// the resulting status code, or error, and latency. The application ID in the
+// Security-AppName query parameter is redacted from the logged URL.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			u := redactedURL(req.URL)
+			resp, err := next(req)
+			latency := time.Since(start)
+			if err != nil {
+				logger.ErrorContext(req.Context(), "ebay: request failed",
+					"url", u, "latency", latency, "error", err)
+				return resp, err
+			}
+			logger.InfoContext(req.Context(), "ebay: request completed",
+				"url", u, "status", resp.StatusCode, "latency", latency)
+			return resp, nil
+		}
+	}
+}
+
+// redactedAppName replaces the application ID in logged and printed requests.
+const redactedAppName = "REDACTED"
+
+// redactedURL returns u as a string with the value of its Security-AppName query
+// parameter replaced, so that logs do not leak the application ID.
+func redactedURL(u *url.URL) string {
+	qry := u.Query()
+	if !qry.Has(queryAppName) {
+		return u.String()
+	}
+	qry.Set(queryAppName, redactedAppName)
+	r := *u
+	r.RawQuery = qry.Encode()
+	return r.String()
+}
+
+// RetryMiddleware returns a Middleware that retries requests failing with a transport error,
+// a 5xx status code, or a 429 status code. It makes at most attempts requests, waiting
+// backoff multiplied by the attempt number between them. Retries stop when the request's
+// context is done.
+//
+// RetryMiddleware cannot see failures eBay reports in a response body with a 200 status
+// code. To retry calls failing with transient eBay System errors, retry the call when
+// [IsRetryable] reports true.
+func RetryMiddleware(attempts int, backoff time.Duration) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			var (
+				resp *http.Response
+				err  error
+			)
+			for i := 1; ; i++ {
+				resp, err = next(req)
+				if i >= attempts || !retryable(resp, err) {
+					return resp, err
+				}
+				if resp != nil {
+					_, _ = io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+				}
+				t := time.NewTimer(backoff * time.Duration(i))
+				select {
+				case <-req.Context().Done():
+					t.Stop()
+					return nil, req.Context().Err()
+				case <-t.C:
+				}
+			}
+		}
+	}
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return retryableStatus(resp.StatusCode)
+}
+
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFindingClient_Middleware(t *testing.T) {
+	t.Parallel()
+	t.Run("RunsInOrder", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		var calls []string
+		record := func(name string) Middleware {
+			return func(next RoundTripFunc) RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					calls = append(calls, name+" before")
+					resp, err := next(req)
+					calls = append(calls, name+" after")
+					return resp, err
+				}
+			}
+		}
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.Middleware = []Middleware{record("first"), record("second")}
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+		want := []string{"first before", "second before", "second after", "first after"}
+		if !reflect.DeepEqual(calls, want) {
+			t.Errorf("middleware calls = %v, want %v", calls, want)
+		}
+	})
+
+	t.Run("ShortCircuit", func(t *testing.T) {
+		t.Parallel()
+		shortCircuit := func(RoundTripFunc) RoundTripFunc {
+			return func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     make(http.Header),
+					Body:       io.NopCloser(strings.NewReader(`{"findItemsByKeywordsResponse":[{"ack":["Success"]}]}`)),
+				}, nil
+			}
+		}
+		unreached := func(RoundTripFunc) RoundTripFunc {
+			return func(*http.Request) (*http.Response, error) {
+				t.Error("middleware after short-circuit was called")
+				return nil, nil
+			}
+		}
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.URL = "http://localhost"
+		client.Middleware = []Middleware{shortCircuit, unreached}
+		got, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+		want := &FindItemsByKeywordsResponse{ItemsResponse: []FindItemsResponse{{Ack: []string{"Success"}}}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("FindingClient.FindItemsByKeywords() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}))
+	defer ts.Close()
+	var buf bytes.Buffer
+	client := NewFindingClient(ts.Client(), "ebay-app-id")
+	client.URL = ts.URL
+	client.Middleware = []Middleware{LoggingMiddleware(slog.New(slog.NewTextHandler(&buf, nil)))}
+	_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+	if err != nil {
+		t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "status=200") || !strings.Contains(got, "keywords=testword") {
+		t.Errorf("LoggingMiddleware() logged %q, want status and URL", got)
+	}
+	if got := buf.String(); strings.Contains(got, "ebay-app-id") || !strings.Contains(got, "Security-AppName=REDACTED") {
+		t.Errorf("LoggingMiddleware() logged %q, want redacted application ID", got)
+	}
+}
+
+func TestRetryMiddleware(t *testing.T) {
+	t.Parallel()
+	t.Run("RetriesServerErrors", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(&FindItemsByKeywordsResponse{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.Middleware = []Middleware{RetryMiddleware(3, 0)}
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+		if got := calls.Load(); got != 3 {
+			t.Errorf("server received %d requests, want 3", got)
+		}
+	})
+
+	t.Run("StopsAfterAttempts", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.Middleware = []Middleware{RetryMiddleware(2, 0)}
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err == nil {
+			t.Fatal("FindingClient.FindItemsByKeywords() error = nil, want error")
+		}
+		if got := calls.Load(); got != 2 {
+			t.Errorf("server received %d requests, want 2", got)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// A RoundTripObserver observes the requests a [FindingClient] makes, for example
+// to record them as tracing spans. Install it with [ObserverMiddleware].
+type RoundTripObserver interface {
+	// ObserveRoundTrip is called before the request req for the eBay Finding API
+	// operation op, such as findItemsByKeywords, is sent. It returns a function that
+	// is called once the response body has been closed or the request has failed.
+	ObserveRoundTrip(ctx context.Context, op Operation, req *http.Request) func(RoundTripInfo)
+}
+
+// RoundTripInfo describes a completed request to the eBay Finding API.
+type RoundTripInfo struct {
+	// AppID is the eBay application ID the request was sent with.
+	AppID string
+
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+
+	// Bytes is the number of response body bytes read.
+	Bytes int64
+
+	// Latency is the time taken from sending the request to closing the response body.
+	Latency time.Duration
+
+	// Err is the error returned by the round trip, if any.
+	Err error
+}
+
+// ObserverMiddleware returns a Middleware that reports every request to o, along with
+// its operation taken from the Operation-Name query parameter. Placed after
+// [RetryMiddleware], it observes each attempt; placed before, each call.
+func ObserverMiddleware(o RoundTripObserver) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			qry := req.URL.Query()
+			op := Operation(qry.Get(queryOperationName))
+			info := &RoundTripInfo{AppID: qry.Get(queryAppName)}
+			start := time.Now()
+			done := o.ObserveRoundTrip(req.Context(), op, req)
+			resp, err := next(req)
+			if err != nil {
+				info.Latency = time.Since(start)
+				info.Err = err
+				done(*info)
+				return resp, err
+			}
+			info.StatusCode = resp.StatusCode
+			resp.Body = &observedBody{ReadCloser: resp.Body, info: info, start: start, done: done}
+			return resp, nil
+		}
+	}
+}
+
+// observedBody counts the bytes read from an HTTP response body and reports the
+// round trip to a RoundTripObserver when the body is closed.
+type observedBody struct {
+	io.ReadCloser
+	info  *RoundTripInfo
+	start time.Time
+	done  func(RoundTripInfo)
+	once  sync.Once
+}
+
+func (b *observedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.info.Bytes += int64(n)
+	return n, err
+}
+
+func (b *observedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.info.Latency = time.Since(b.start)
+		b.done(*b.info)
+	})
+	return err
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type observerFunc func(ctx context.Context, op Operation, req *http.Request) func(RoundTripInfo)
+
+func (f observerFunc) ObserveRoundTrip(ctx context.Context, op Operation, req *http.Request) func(RoundTripInfo) {
+	return f(ctx, op, req)
+}
+
+func TestObserverMiddleware(t *testing.T) {
+	t.Parallel()
+	t.Run("ResponseSuccess", func(t *testing.T) {
+		t.Parallel()
+		body := `{"findItemsByKeywordsResponse":[{"ack":["Success"]}]}`
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(body)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		var (
+			gotOp   Operation
+			gotInfo RoundTripInfo
+			calls   int
+		)
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		client.Middleware = []Middleware{ObserverMiddleware(observerFunc(func(_ context.Context, op Operation, _ *http.Request) func(RoundTripInfo) {
+			gotOp = op
+			return func(info RoundTripInfo) {
+				calls++
+				gotInfo = info
+			}
+		}))}
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+		}
+		if gotOp != OperationByKeywords {
+			t.Errorf("observed operation = %q, want %q", gotOp, OperationByKeywords)
+		}
+		if calls != 1 {
+			t.Errorf("observer done called %d times, want 1", calls)
+		}
+		if gotInfo.StatusCode != http.StatusOK || gotInfo.Bytes != int64(len(body)) || gotInfo.Err != nil {
+			t.Errorf("observed info = %+v, want status %d, %d bytes, nil error", gotInfo, http.StatusOK, len(body))
+		}
+	})
+
+	t.Run("ClientDoError", func(t *testing.T) {
+		t.Parallel()
+		var gotInfo RoundTripInfo
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		client.URL = "http://localhost"
+		client.Middleware = []Middleware{ObserverMiddleware(observerFunc(func(context.Context, Operation, *http.Request) func(RoundTripInfo) {
+			return func(info RoundTripInfo) {
+				gotInfo = info
+			}
+		}))}
+		_, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrFailedRequest) {
+			t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want %v", err, ErrFailedRequest)
+		}
+		if gotInfo.StatusCode != 0 || gotInfo.Err == nil {
+			t.Errorf("observed info = %+v, want status 0 and an error", gotInfo)
+		}
+	})
+}
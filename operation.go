@@ -0,0 +1,40 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Operation is an eBay Finding API operation, named as in the Operation-Name query parameter.
+type Operation string
+
+// The eBay Finding API operations supported by [FindingClient].
+const (
+	OperationAdvanced   Operation = "findItemsAdvanced"
+	OperationByCategory Operation = "findItemsByCategory"
+	OperationByKeywords Operation = "findItemsByKeywords"
+	OperationByProduct  Operation = "findItemsByProduct"
+	OperationInStores   Operation = "findItemsIneBayStores"
+)
+
+var operations = []Operation{
+	OperationAdvanced, OperationByCategory, OperationByKeywords, OperationByProduct, OperationInStores,
+}
+
+// String returns the operation name, such as findItemsByKeywords.
+func (o Operation) String() string {
+	return string(o)
+}
+
+// ParseOperation returns the Operation named s, such as findItemsByKeywords.
+// ParseOperation returns [ErrUnsupportedOperation] if s is not a supported operation.
+func ParseOperation(s string) (Operation, error) {
+	op := Operation(s)
+	if !slices.Contains(operations, op) {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedOperation, s)
+	}
+	return op, nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseOperation(t *testing.T) {
+	t.Parallel()
+	for _, op := range operations {
+		got, err := ParseOperation(op.String())
+		if err != nil {
+			t.Errorf("ParseOperation(%q) error = %v, want nil", op, err)
+		}
+		if got != op {
+			t.Errorf("ParseOperation(%q) = %q, want %q", op, got, op)
+		}
+	}
+	for _, s := range []string{"", "findItemsEverywhere", "FINDITEMSBYKEYWORDS"} {
+		_, err := ParseOperation(s)
+		if !errors.Is(err, ErrUnsupportedOperation) {
+			t.Errorf("ParseOperation(%q) error = %v, want %v", s, err, ErrUnsupportedOperation)
+		}
+	}
+}
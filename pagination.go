@@ -0,0 +1,169 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+)
+
+const (
+	paramEntriesPerPage   = "paginationInput.entriesPerPage"
+	paramPageNumber       = "paginationInput.pageNumber"
+	minPaginationValue    = 1
+	maxEntriesPerPage     = 100
+	defaultEntriesPerPage = 100
+)
+
+// maxPageNumber is the largest page number the eBay Finding API accepts, regardless of
+// the number of entries per page. With at most 100 entries per page, every accepted page
+// lies within the 10,000 entries eBay returns for a search.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/PaginationInput.html.
+const maxPageNumber = 100
+
+var (
+	// ErrInvalidEntriesPerPage is returned when paginationInput.entriesPerPage is out of range.
+	ErrInvalidEntriesPerPage = fmt.Errorf("ebay: entries per page must be between %d and %d",
+		minPaginationValue, maxEntriesPerPage)
+
+	// ErrInvalidPageNumber is returned when paginationInput.pageNumber is out of range.
+	ErrInvalidPageNumber = fmt.Errorf("ebay: page number must be between %d and %d",
+		minPaginationValue, maxPageNumber)
+)
+
+// SetPagination sets the paginationInput.entriesPerPage and paginationInput.pageNumber
+// params in params to entriesPerPage and pageNumber.
+//
+// SetPagination returns [ErrInvalidEntriesPerPage] if entriesPerPage is not between 1 and 100,
+// and [ErrInvalidPageNumber] if pageNumber is not between 1 and 100. params is not
+// modified if SetPagination returns an error. Like any map assignment, SetPagination
+// panics if params is nil.
+func SetPagination(params map[string]string, entriesPerPage, pageNumber int) error {
+	if err := validatePagination(entriesPerPage, pageNumber); err != nil {
+		return err
+	}
+	params[paramEntriesPerPage] = strconv.Itoa(entriesPerPage)
+	params[paramPageNumber] = strconv.Itoa(pageNumber)
+	return nil
+}
+
+// GotoPage searches for items on eBay using the eBay Finding API operation op,
+// returning page n of the results. The params are not modified.
+//
+// GotoPage returns [ErrInvalidPageNumber] if n is not between 1 and 100.
+func (c *FindingClient) GotoPage(ctx context.Context, op Operation, params map[string]string, n int) (ResultProvider, error) {
+	if _, err := entriesPerPage(params); err != nil {
+		return nil, err
+	}
+	if err := validatePageNumber(n); err != nil {
+		return nil, err
+	}
+	p := maps.Clone(params)
+	if p == nil {
+		p = make(map[string]string)
+	}
+	p[paramPageNumber] = strconv.Itoa(n)
+	return c.FindItems(ctx, op, p)
+}
+
+// LastPage searches for items on eBay using the eBay Finding API operation op,
+// returning the last reachable page of the results. It fetches the first page to
+// determine the total number of pages, then fetches the last page if there is more than one.
+func (c *FindingClient) LastPage(ctx context.Context, op Operation, params map[string]string) (ResultProvider, error) {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
+	first, err := c.GotoPage(ctx, op, params, minPaginationValue)
+	if err != nil {
+		return nil, err
+	}
+	last := min(totalPages(first), maxPageNumber)
+	if last <= minPaginationValue {
+		return first, nil
+	}
+	return c.GotoPage(ctx, op, params, last)
+}
+
+// CountItemsByKeywords returns the total number of items matching the keywords search
+// with the given params, without transferring the items. It requests a single entry
+// per page using [FindingClient.FindItemsByKeywords]. The params are not modified.
+func (c *FindingClient) CountItemsByKeywords(ctx context.Context, params map[string]string) (int, error) {
+	p := maps.Clone(params)
+	if p == nil {
+		p = make(map[string]string)
+	}
+	p[paramEntriesPerPage] = strconv.Itoa(minPaginationValue)
+	delete(p, paramPageNumber)
+	res, err := c.FindItemsByKeywords(ctx, p)
+	if err != nil {
+		return 0, err
+	}
+	results := res.Results()
+	if len(results) == 0 || len(results[0].PaginationOutput) == 0 ||
+		len(results[0].PaginationOutput[0].TotalEntries) == 0 {
+		return 0, nil
+	}
+	v := results[0].PaginationOutput[0].TotalEntries[0]
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%w: totalEntries %q", ErrDecodeAPIResponse, v)
+	}
+	return n, nil
+}
+
+func validatePagination(entriesPerPage, pageNumber int) error {
+	if entriesPerPage < minPaginationValue || entriesPerPage > maxEntriesPerPage {
+		return &ValidationError{Err: ErrInvalidEntriesPerPage, Key: paramEntriesPerPage, Value: strconv.Itoa(entriesPerPage)}
+	}
+	return validatePageNumber(pageNumber)
+}
+
+// validatePaginationParams validates the paginationInput params in params, if set.
+func validatePaginationParams(params map[string]string) error {
+	if _, err := entriesPerPage(params); err != nil {
+		return err
+	}
+	v, ok := params[paramPageNumber]
+	if !ok || v == "" {
+		return nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return &ValidationError{Err: ErrInvalidPageNumber, Key: paramPageNumber, Value: v}
+	}
+	return validatePageNumber(n)
+}
+
+func validatePageNumber(n int) error {
+	if n < minPaginationValue || n > maxPageNumber {
+		return &ValidationError{Err: ErrInvalidPageNumber, Key: paramPageNumber, Value: strconv.Itoa(n)}
+	}
+	return nil
+}
+
+func entriesPerPage(params map[string]string) (int, error) {
+	v, ok := params[paramEntriesPerPage]
+	if !ok || v == "" {
+		return defaultEntriesPerPage, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < minPaginationValue || n > maxEntriesPerPage {
+		return 0, &ValidationError{Err: ErrInvalidEntriesPerPage, Key: paramEntriesPerPage, Value: v}
+	}
+	return n, nil
+}
+
+func totalPages(res ResultProvider) int {
+	results := res.Results()
+	if len(results) == 0 || len(results[0].PaginationOutput) == 0 ||
+		len(results[0].PaginationOutput[0].TotalPages) == 0 {
+		return 0
+	}
+	n, err := strconv.Atoi(results[0].PaginationOutput[0].TotalPages[0])
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,298 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func pagedServer(t *testing.T, total string) (*httptest.Server, func() []string) {
+	t.Helper()
+	var (
+		mu    sync.Mutex
+		pages []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get(paramPageNumber)
+		mu.Lock()
+		pages = append(pages, page)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		res := FindItemsByKeywordsResponse{
+			ItemsResponse: []FindItemsResponse{{
+				PaginationOutput: []PaginationOutput{{
+					PageNumber: []string{page},
+					TotalPages: []string{total},
+				}},
+			}},
+		}
+		if err := json.NewEncoder(w).Encode(&res); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	return ts, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return pages
+	}
+}
+
+func TestFindingClient_GotoPage(t *testing.T) {
+	t.Parallel()
+	t.Run("ResponseSuccess", func(t *testing.T) {
+		t.Parallel()
+		ts, pages := pagedServer(t, "5")
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		params := map[string]string{"keywords": "testword"}
+		got, err := client.GotoPage(context.Background(), OperationByKeywords, params, 3)
+		if err != nil {
+			t.Fatalf("FindingClient.GotoPage() error = %v, want nil", err)
+		}
+		if _, ok := got.(*FindItemsByKeywordsResponse); !ok {
+			t.Errorf("FindingClient.GotoPage() = %T, want *FindItemsByKeywordsResponse", got)
+		}
+		if want := []string{"3"}; !reflect.DeepEqual(pages(), want) {
+			t.Errorf("FindingClient.GotoPage() requested pages %v, want %v", pages(), want)
+		}
+		if _, ok := params[paramPageNumber]; ok {
+			t.Errorf("FindingClient.GotoPage() modified params: %v", params)
+		}
+	})
+
+	t.Run("InvalidPageNumberError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		for _, n := range []int{0, 101} {
+			_, err := client.GotoPage(context.Background(), OperationByKeywords, map[string]string{}, n)
+			if !errors.Is(err, ErrInvalidPageNumber) {
+				t.Errorf("FindingClient.GotoPage(%d) error = %v, want %v", n, err, ErrInvalidPageNumber)
+			}
+		}
+	})
+
+	t.Run("MaxPageNumber", func(t *testing.T) {
+		t.Parallel()
+		ts, pages := pagedServer(t, "500")
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		params := map[string]string{"keywords": "testword", paramEntriesPerPage: "20"}
+		_, err := client.GotoPage(context.Background(), OperationByKeywords, params, 100)
+		if err != nil {
+			t.Fatalf("FindingClient.GotoPage() error = %v, want nil", err)
+		}
+		if want := []string{"100"}; !reflect.DeepEqual(pages(), want) {
+			t.Errorf("FindingClient.GotoPage() requested pages %v, want %v", pages(), want)
+		}
+	})
+
+	t.Run("InvalidEntriesPerPageError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		params := map[string]string{paramEntriesPerPage: "101"}
+		_, err := client.GotoPage(context.Background(), OperationByKeywords, params, 1)
+		if !errors.Is(err, ErrInvalidEntriesPerPage) {
+			t.Errorf("FindingClient.GotoPage() error = %v, want %v", err, ErrInvalidEntriesPerPage)
+		}
+	})
+
+	t.Run("UnsupportedOperationError", func(t *testing.T) {
+		t.Parallel()
+		client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+		_, err := client.GotoPage(context.Background(), "findItemsEverywhere", map[string]string{}, 1)
+		if !errors.Is(err, ErrUnsupportedOperation) {
+			t.Errorf("FindingClient.GotoPage() error = %v, want %v", err, ErrUnsupportedOperation)
+		}
+	})
+}
+
+func TestFindingClient_LastPage(t *testing.T) {
+	t.Parallel()
+	t.Run("MultiplePages", func(t *testing.T) {
+		t.Parallel()
+		ts, pages := pagedServer(t, "5")
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		got, err := client.LastPage(context.Background(), OperationByKeywords, map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.LastPage() error = %v, want nil", err)
+		}
+		if page := got.Results()[0].PaginationOutput[0].PageNumber[0]; page != "5" {
+			t.Errorf("FindingClient.LastPage() page = %s, want 5", page)
+		}
+		if want := []string{"1", "5"}; !reflect.DeepEqual(pages(), want) {
+			t.Errorf("FindingClient.LastPage() requested pages %v, want %v", pages(), want)
+		}
+	})
+
+	t.Run("SinglePage", func(t *testing.T) {
+		t.Parallel()
+		ts, pages := pagedServer(t, "1")
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		_, err := client.LastPage(context.Background(), OperationByKeywords, map[string]string{"keywords": "testword"})
+		if err != nil {
+			t.Fatalf("FindingClient.LastPage() error = %v, want nil", err)
+		}
+		if want := []string{"1"}; !reflect.DeepEqual(pages(), want) {
+			t.Errorf("FindingClient.LastPage() requested pages %v, want %v", pages(), want)
+		}
+	})
+
+	t.Run("ClampsToMaxPageNumber", func(t *testing.T) {
+		t.Parallel()
+		ts, pages := pagedServer(t, "500")
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		params := map[string]string{"keywords": "testword", paramEntriesPerPage: "20"}
+		_, err := client.LastPage(context.Background(), OperationByKeywords, params)
+		if err != nil {
+			t.Fatalf("FindingClient.LastPage() error = %v, want nil", err)
+		}
+		if want := []string{"1", "100"}; !reflect.DeepEqual(pages(), want) {
+			t.Errorf("FindingClient.LastPage() requested pages %v, want %v", pages(), want)
+		}
+	})
+}
+
+func TestFindingClient_CountItemsByKeywords(t *testing.T) {
+	t.Parallel()
+	t.Run("ResponseSuccess", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			qry := r.URL.Query()
+			if got := qry.Get(paramEntriesPerPage); got != "1" {
+				t.Errorf("%s = %q, want 1", paramEntriesPerPage, got)
+			}
+			if qry.Has(paramPageNumber) {
+				t.Errorf("%s = %q, want unset", paramPageNumber, qry.Get(paramPageNumber))
+			}
+			w.WriteHeader(http.StatusOK)
+			res := FindItemsByKeywordsResponse{
+				ItemsResponse: []FindItemsResponse{{
+					PaginationOutput: []PaginationOutput{{TotalEntries: []string{"1234"}}},
+				}},
+			}
+			if err := json.NewEncoder(w).Encode(&res); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		params := map[string]string{"keywords": "testword", paramEntriesPerPage: "50", paramPageNumber: "2"}
+		got, err := client.CountItemsByKeywords(context.Background(), params)
+		if err != nil {
+			t.Fatalf("FindingClient.CountItemsByKeywords() error = %v, want nil", err)
+		}
+		if got != 1234 {
+			t.Errorf("FindingClient.CountItemsByKeywords() = %d, want 1234", got)
+		}
+		if params[paramEntriesPerPage] != "50" {
+			t.Errorf("FindingClient.CountItemsByKeywords() modified params: %v", params)
+		}
+	})
+
+	t.Run("InvalidTotalEntriesError", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			res := FindItemsByKeywordsResponse{
+				ItemsResponse: []FindItemsResponse{{
+					PaginationOutput: []PaginationOutput{{TotalEntries: []string{"many"}}},
+				}},
+			}
+			if err := json.NewEncoder(w).Encode(&res); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}))
+		defer ts.Close()
+		client := NewFindingClient(ts.Client(), "ebay-app-id")
+		client.URL = ts.URL
+		_, err := client.CountItemsByKeywords(context.Background(), map[string]string{"keywords": "testword"})
+		if !errors.Is(err, ErrDecodeAPIResponse) {
+			t.Errorf("FindingClient.CountItemsByKeywords() error = %v, want %v", err, ErrDecodeAPIResponse)
+		}
+	})
+}
+
+func TestSetPagination(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name           string
+		EntriesPerPage int
+		PageNumber     int
+		Want           map[string]string
+		Err            error
+	}{
+		{
+			Name:           "Valid",
+			EntriesPerPage: 25,
+			PageNumber:     3,
+			Want:           map[string]string{"keywords": "testword", paramEntriesPerPage: "25", paramPageNumber: "3"},
+		},
+		{Name: "InvalidEntriesPerPage", EntriesPerPage: 101, PageNumber: 1, Err: ErrInvalidEntriesPerPage},
+		{Name: "InvalidPageNumber", EntriesPerPage: 25, PageNumber: 0, Err: ErrInvalidPageNumber},
+		{Name: "PageNumberAboveMax", EntriesPerPage: 25, PageNumber: 101, Err: ErrInvalidPageNumber},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			params := map[string]string{"keywords": "testword"}
+			err := SetPagination(params, tc.EntriesPerPage, tc.PageNumber)
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("SetPagination() error = %v, want %v", err, tc.Err)
+			}
+			want := tc.Want
+			if tc.Err != nil {
+				want = map[string]string{"keywords": "testword"}
+			}
+			if !reflect.DeepEqual(params, want) {
+				t.Errorf("SetPagination() params = %v, want %v", params, want)
+			}
+		})
+	}
+}
+
+func TestFindParams_SetPaginationValidation(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name           string
+		EntriesPerPage int
+		PageNumber     int
+		Err            error
+	}{
+		{Name: "Valid", EntriesPerPage: 25, PageNumber: 3},
+		{Name: "Unset", EntriesPerPage: 0, PageNumber: 0},
+		{Name: "InvalidEntriesPerPage", EntriesPerPage: 101, PageNumber: 1, Err: ErrInvalidEntriesPerPage},
+		{Name: "InvalidPageNumber", EntriesPerPage: 25, PageNumber: 101, Err: ErrInvalidPageNumber},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			var p FindParams
+			p.Set("keywords", "testword").SetPagination(tc.EntriesPerPage, tc.PageNumber)
+			if err := p.Validate(); !errors.Is(err, tc.Err) {
+				t.Errorf("FindParams.Validate() error = %v, want %v", err, tc.Err)
+			}
+			client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+			_, err := client.BuildRequestURL(context.Background(), OperationByKeywords, p.Map())
+			if !errors.Is(err, tc.Err) {
+				t.Errorf("FindingClient.BuildRequestURL() error = %v, want %v", err, tc.Err)
+			}
+		})
+	}
+}
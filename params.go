@@ -0,0 +1,391 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MaxAspectFilters is the maximum number of aspect filters in a search. BuildAspectFilterParams
+// and the find methods reject params with more. It may be changed to follow eBay's limit,
+// but only before any params are built or requests are made.
+var MaxAspectFilters = 100
+
+// ErrInvalidAspectFilter is returned when an aspect filter has no aspect name or no aspect values.
+var ErrInvalidAspectFilter = errors.New("ebay: aspect filter must have a name and at least one value")
+
+// ErrMaxAspectFilters is returned when a search has more than the maximum number of aspect filters.
+var ErrMaxAspectFilters = errors.New("ebay: maximum number of aspect filters exceeded")
+
+// ErrMultiValuedParam is returned when a param has more than one value. The eBay Finding API
+// expects repeated values as indexed keys, such as itemFilter(0).value(1), instead.
+var ErrMultiValuedParam = errors.New("ebay: param has multiple values")
+
+// ErrIndexedKeywords is returned when keywords are given with indexed keys, such as keywords(0).
+// The eBay Finding API only accepts a single keywords param.
+var ErrIndexedKeywords = errors.New("ebay: keywords must not be indexed")
+
+// ErrMissingRequiredParam is returned when params lack the params an operation requires,
+// such as keywords for findItemsByKeywords.
+var ErrMissingRequiredParam = errors.New("ebay: missing required param")
+
+// requiredParams lists, for each operation, the params of which at least one must be set.
+// See https://developer.ebay.com/Devzone/finding/CallRef/index.html.
+var requiredParams = map[Operation][]string{
+	OperationAdvanced:   {"categoryId", "keywords"},
+	OperationByCategory: {"categoryId"},
+	OperationByKeywords: {"keywords"},
+	OperationByProduct:  {"productId"},
+	OperationInStores:   {"storeName", "keywords", "categoryId"},
+}
+
+// aspectFilterKeyPattern matches an aspect filter param key, capturing the filter prefix,
+// such as aspectFilter(0), and the field, such as aspectName or aspectValueName(1).
+var aspectFilterKeyPattern = regexp.MustCompile(`^(aspectFilter(?:\(\d+\))?)\.(aspectName|aspectValueName(?:\(\d+\))?)$`)
+
+// entityPattern matches one of the predefined XML entities or a character reference
+// at the start of a string. Other names, as in Tom&Jerry;, are not entities eBay accepts.
+var entityPattern = regexp.MustCompile(`^&(?:amp|lt|gt|quot|apos|#[0-9]+|#x[0-9A-Fa-f]+);`)
+
+// FindParams builds the params map passed to the [FindingClient] find methods,
+// taking care of eBay's indexed query-key syntax such as itemFilter(0).value(1).
+// The zero value is an empty set of params ready to use.
+//
+// FindParams can be built with its methods or by setting its fields directly.
+type FindParams struct {
+	// ItemFilters are the item filters emitted as itemFilter(i) params.
+	ItemFilters []ItemFilter
+
+	// AspectFilters are the aspect filters emitted as aspectFilter(i) params.
+	AspectFilters []AspectFilter
+
+	// Affiliate is the affiliate tracking information emitted as affiliate params, if any.
+	Affiliate *Affiliate
+
+	// PaginationInput controls the pagination of the results, if set.
+	PaginationInput *PaginationInput
+
+	// SortOrder is the sort order of the results, if set.
+	SortOrder string
+
+	params map[string]string
+}
+
+// ItemFilter represents an item filter used to reduce the number of items returned by a search.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/ItemFilter.html.
+type ItemFilter struct {
+	// Name is the name of the item filter, such as MaxPrice or Condition.
+	Name string
+
+	// Values are the values of the item filter.
+	Values []string
+
+	// ParamName is an optional additional parameter name, such as Currency for MaxPrice.
+	ParamName string
+
+	// ParamValue is the value of ParamName, such as EUR.
+	ParamValue string
+}
+
+// AspectFilter represents an aspect filter used to refine a search by item aspects.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/AspectFilter.html.
+type AspectFilter struct {
+	// AspectName is the name of the aspect, such as Brand.
+	AspectName string
+
+	// AspectValueNames are the aspect values to match, such as Apple.
+	AspectValueNames []string
+}
+
+// Affiliate represents the affiliate tracking information used to earn commissions.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/Affiliate.html.
+type Affiliate struct {
+	// CustomID is an optional value used to track click-throughs.
+	CustomID string
+
+	// GeoTargeting reports whether the affiliate tracking is geo-targeted.
+	// Geo-targeting requires the buyerPostalCode param to be set.
+	GeoTargeting bool
+
+	// NetworkID specifies the affiliate tracking partner, such as 9 for the eBay Partner Network.
+	NetworkID string
+
+	// TrackingID is the affiliate's tracking ID, such as the eBay Partner Network campaign ID.
+	TrackingID string
+}
+
+// PaginationInput controls the pagination of the results.
+// A zero field leaves the corresponding param unset, so eBay applies its default.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/PaginationInput.html.
+type PaginationInput struct {
+	// EntriesPerPage is the number of items to return per page.
+	EntriesPerPage int
+
+	// PageNumber is the page of results to return.
+	PageNumber int
+}
+
+// Set sets the param key to value, such as keywords or categoryId.
+func (p *FindParams) Set(key, value string) *FindParams {
+	if p.params == nil {
+		p.params = make(map[string]string)
+	}
+	p.params[key] = value
+	return p
+}
+
+// AddItemFilter adds an item filter with the given name and values.
+// The paramName and paramValue are optional and may be nil, for example
+// to specify the Currency of a MaxPrice filter.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/ItemFilterType.html.
+func (p *FindParams) AddItemFilter(name string, values []string, paramName, paramValue *string) *FindParams {
+	f := ItemFilter{Name: name, Values: values}
+	if paramName != nil {
+		f.ParamName = *paramName
+	}
+	if paramValue != nil {
+		f.ParamValue = *paramValue
+	}
+	p.ItemFilters = append(p.ItemFilters, f)
+	return p
+}
+
+// AddAspectFilter adds an aspect filter with the given aspect name and aspect value names.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/AspectFilter.html.
+func (p *FindParams) AddAspectFilter(name string, values []string) *FindParams {
+	p.AspectFilters = append(p.AspectFilters, AspectFilter{AspectName: name, AspectValueNames: values})
+	return p
+}
+
+// SetPagination sets the number of entries per page and the page number to return.
+// A zero value leaves the corresponding param unset, so eBay applies its default.
+// Out-of-range values are reported by [FindParams.Validate] and the [FindingClient]
+// find methods, using the same checks as the package-level [SetPagination].
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/PaginationInput.html.
+func (p *FindParams) SetPagination(entriesPerPage, pageNumber int) *FindParams {
+	p.PaginationInput = &PaginationInput{EntriesPerPage: entriesPerPage, PageNumber: pageNumber}
+	return p
+}
+
+// SetSortOrder sets the sort order of the search results, such as BestMatch or PricePlusShippingLowest.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/SortOrderType.html.
+func (p *FindParams) SetSortOrder(sortOrder string) *FindParams {
+	p.SortOrder = sortOrder
+	return p
+}
+
+// SetAffiliate sets the affiliate tracking information.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/Affiliate.html.
+func (p *FindParams) SetAffiliate(a Affiliate) *FindParams {
+	p.Affiliate = &a
+	return p
+}
+
+// BuildItemFilterParams returns the indexed itemFilter(i).name, itemFilter(i).value(j),
+// itemFilter(i).paramName, and itemFilter(i).paramValue params for filters, ready to
+// be merged into the params map passed to the [FindingClient] find methods.
+func BuildItemFilterParams(filters []ItemFilter) map[string]string {
+	m := make(map[string]string)
+	for i, f := range filters {
+		prefix := fmt.Sprintf("itemFilter(%d)", i)
+		m[prefix+".name"] = f.Name
+		for j, v := range f.Values {
+			m[fmt.Sprintf("%s.value(%d)", prefix, j)] = v
+		}
+		if f.ParamName != "" {
+			m[prefix+".paramName"] = f.ParamName
+		}
+		if f.ParamValue != "" {
+			m[prefix+".paramValue"] = f.ParamValue
+		}
+	}
+	return m
+}
+
+// BuildAspectFilterParams returns the indexed aspectFilter(i).aspectName and
+// aspectFilter(i).aspectValueName(j) params for filters, ready to be merged into the
+// params map passed to the [FindingClient] find methods. BuildAspectFilterParams returns
+// [ErrInvalidAspectFilter] if a filter has no aspect name, no aspect value names, or an
+// empty aspect value name, and [ErrMaxAspectFilters] if there are more than [MaxAspectFilters] filters.
+func BuildAspectFilterParams(filters []AspectFilter) (map[string]string, error) {
+	m := aspectFilterParams(filters)
+	if err := validateAspectFilterParams(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func aspectFilterParams(filters []AspectFilter) map[string]string {
+	m := make(map[string]string)
+	for i, f := range filters {
+		prefix := fmt.Sprintf("aspectFilter(%d)", i)
+		m[prefix+".aspectName"] = f.AspectName
+		for j, v := range f.AspectValueNames {
+			m[fmt.Sprintf("%s.aspectValueName(%d)", prefix, j)] = v
+		}
+	}
+	return m
+}
+
+// validateAspectFilterParams reports whether params has at most MaxAspectFilters aspect
+// filters, each with a non-blank aspectName and at least one aspectValueName, none of
+// which are blank.
+func validateAspectFilterParams(params map[string]string) error {
+	var prefixes []string
+	named := make(map[string]bool)
+	valued := make(map[string]bool)
+	for _, k := range slices.Sorted(maps.Keys(params)) {
+		m := aspectFilterKeyPattern.FindStringSubmatch(k)
+		if m == nil {
+			continue
+		}
+		prefix, v := m[1], params[k]
+		if !named[prefix] && !valued[prefix] {
+			prefixes = append(prefixes, prefix)
+		}
+		if strings.TrimSpace(v) == "" {
+			return &ValidationError{Err: ErrInvalidAspectFilter, Key: k, Value: v}
+		}
+		if m[2] == "aspectName" {
+			named[prefix] = true
+		} else {
+			valued[prefix] = true
+		}
+	}
+	if len(prefixes) > MaxAspectFilters {
+		return fmt.Errorf("%w: %d filters, maximum %d", ErrMaxAspectFilters, len(prefixes), MaxAspectFilters)
+	}
+	for _, prefix := range prefixes {
+		if !named[prefix] {
+			return &ValidationError{Err: ErrInvalidAspectFilter, Key: prefix + ".aspectName"}
+		}
+		if !valued[prefix] {
+			return &ValidationError{Err: ErrInvalidAspectFilter, Key: prefix + ".aspectValueName(0)"}
+		}
+	}
+	return nil
+}
+
+// ParamsFromValues returns the params map expected by the [FindingClient] find methods
+// from values, such as the parsed query of an incoming HTTP request.
+// ParamsFromValues returns [ErrMultiValuedParam] if a key has more than one value.
+func ParamsFromValues(values url.Values) (map[string]string, error) {
+	m := make(map[string]string, len(values))
+	for k, vs := range values {
+		if len(vs) > 1 {
+			return nil, &ValidationError{Err: ErrMultiValuedParam, Key: k, Value: strings.Join(vs, ",")}
+		}
+		m[k] = ""
+		if len(vs) == 1 {
+			m[k] = vs[0]
+		}
+	}
+	return m, nil
+}
+
+// EscapeStoreName returns name with each bare ampersand replaced by &amp;, as eBay
+// requires for the storeName param. Ampersands that already begin a predefined XML entity,
+// such as &amp;, or a character reference, such as &#38;, are left as is, so escaping a
+// store name more than once has no further effect.
+func EscapeStoreName(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] == '&' && !entityPattern.MatchString(name[i:]) {
+			b.WriteString("&amp;")
+			continue
+		}
+		b.WriteByte(name[i])
+	}
+	return b.String()
+}
+
+// ValidateParams reports whether params are valid for the eBay Finding API operation op,
+// without needing a client, such as for middleware that only sees op and params. It returns
+// [ErrUnsupportedOperation] if op is not a supported operation, [ErrMissingRequiredParam]
+// if params lack the params op requires, and otherwise the errors of [FindParams.Validate].
+// The find methods perform the same checks except for required params, which they leave to eBay.
+func ValidateParams(op Operation, params map[string]string) error {
+	names, ok := requiredParams[op]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnsupportedOperation, op)
+	}
+	if !slices.ContainsFunc(names, func(name string) bool { return hasParam(params, name) }) {
+		return fmt.Errorf("%w: %s requires %s", ErrMissingRequiredParam, op, strings.Join(names, " or "))
+	}
+	return validateParams(params)
+}
+
+// hasParam reports whether params has a non-blank value for name, either as name itself
+// or indexed, such as categoryId(0).
+func hasParam(params map[string]string, name string) bool {
+	for k, v := range params {
+		if (k == name || strings.HasPrefix(k, name+"(")) && strings.TrimSpace(v) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateParams validates the params passed to the [FindingClient] find methods.
+func validateParams(params map[string]string) error {
+	for k, v := range params {
+		if strings.HasPrefix(k, "keywords(") {
+			return &ValidationError{Err: ErrIndexedKeywords, Key: k, Value: v}
+		}
+	}
+	if err := validateAspectFilterParams(params); err != nil {
+		return err
+	}
+	if err := validatePaginationParams(params); err != nil {
+		return err
+	}
+	return validateAffiliateParams(params)
+}
+
+// Validate reports whether the params pass the validation the [FindingClient] find methods
+// perform before sending a request, such as the pagination ranges checked by [SetPagination].
+func (p *FindParams) Validate() error {
+	return validateParams(p.Map())
+}
+
+// Map returns the params as the indexed params map expected by the [FindingClient] find methods.
+func (p *FindParams) Map() map[string]string {
+	m := make(map[string]string, len(p.params))
+	maps.Copy(m, p.params)
+	maps.Copy(m, BuildItemFilterParams(p.ItemFilters))
+	maps.Copy(m, aspectFilterParams(p.AspectFilters))
+	if a := p.Affiliate; a != nil {
+		if a.CustomID != "" {
+			m[paramAffiliateCustomID] = a.CustomID
+		}
+		if a.GeoTargeting {
+			m[paramAffiliateGeoTargeting] = "true"
+		}
+		if a.NetworkID != "" {
+			m[paramAffiliateNetworkID] = a.NetworkID
+		}
+		if a.TrackingID != "" {
+			m[paramAffiliateTrackingID] = a.TrackingID
+		}
+	}
+	if pi := p.PaginationInput; pi != nil {
+		if pi.EntriesPerPage != 0 {
+			m[paramEntriesPerPage] = strconv.Itoa(pi.EntriesPerPage)
+		}
+		if pi.PageNumber != 0 {
+			m[paramPageNumber] = strconv.Itoa(pi.PageNumber)
+		}
+	}
+	if p.SortOrder != "" {
+		m["sortOrder"] = p.SortOrder
+	}
+	return m
+}
@@ -0,0 +1,359 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestFindParams_Map(t *testing.T) {
+	t.Parallel()
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+		var p FindParams
+		if got := p.Map(); len(got) != 0 {
+			t.Errorf("FindParams.Map() = %v, want empty map", got)
+		}
+	})
+
+	t.Run("AllParams", func(t *testing.T) {
+		t.Parallel()
+		paramName, paramValue := "Currency", "EUR"
+		var p FindParams
+		p.Set("keywords", "iphone").
+			AddItemFilter("Condition", []string{"1000", "3000"}, nil, nil).
+			AddItemFilter("MaxPrice", []string{"500.0"}, &paramName, &paramValue).
+			AddAspectFilter("Brand", []string{"Apple"}).
+			SetPagination(25, 2).
+			SetSortOrder("PricePlusShippingLowest")
+		want := map[string]string{
+			"keywords":                           "iphone",
+			"itemFilter(0).name":                 "Condition",
+			"itemFilter(0).value(0)":             "1000",
+			"itemFilter(0).value(1)":             "3000",
+			"itemFilter(1).name":                 "MaxPrice",
+			"itemFilter(1).value(0)":             "500.0",
+			"itemFilter(1).paramName":            "Currency",
+			"itemFilter(1).paramValue":           "EUR",
+			"aspectFilter(0).aspectName":         "Brand",
+			"aspectFilter(0).aspectValueName(0)": "Apple",
+			"paginationInput.entriesPerPage":     "25",
+			"paginationInput.pageNumber":         "2",
+			"sortOrder":                          "PricePlusShippingLowest",
+		}
+		if got := p.Map(); !reflect.DeepEqual(got, want) {
+			t.Errorf("FindParams.Map() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("PartialPagination", func(t *testing.T) {
+		t.Parallel()
+		var p FindParams
+		p.SetPagination(50, 0)
+		want := map[string]string{"paginationInput.entriesPerPage": "50"}
+		if got := p.Map(); !reflect.DeepEqual(got, want) {
+			t.Errorf("FindParams.Map() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Fields", func(t *testing.T) {
+		t.Parallel()
+		p := FindParams{
+			ItemFilters: []ItemFilter{
+				{Name: "FreeShippingOnly", Values: []string{"true"}},
+				{Name: "MinPrice", Values: []string{"10.0"}, ParamName: "Currency", ParamValue: "USD"},
+			},
+			AspectFilters:   []AspectFilter{{AspectName: "Color", AspectValueNames: []string{"Red", "Blue"}}},
+			Affiliate:       &Affiliate{NetworkID: "9", TrackingID: "1234567890", CustomID: "abc", GeoTargeting: true},
+			PaginationInput: &PaginationInput{PageNumber: 3},
+		}
+		want := map[string]string{
+			"itemFilter(0).name":                 "FreeShippingOnly",
+			"itemFilter(0).value(0)":             "true",
+			"itemFilter(1).name":                 "MinPrice",
+			"itemFilter(1).value(0)":             "10.0",
+			"itemFilter(1).paramName":            "Currency",
+			"itemFilter(1).paramValue":           "USD",
+			"aspectFilter(0).aspectName":         "Color",
+			"aspectFilter(0).aspectValueName(0)": "Red",
+			"aspectFilter(0).aspectValueName(1)": "Blue",
+			"affiliate.networkId":                "9",
+			"affiliate.trackingId":               "1234567890",
+			"affiliate.customId":                 "abc",
+			"affiliate.geoTargeting":             "true",
+			"paginationInput.pageNumber":         "3",
+		}
+		if got := p.Map(); !reflect.DeepEqual(got, want) {
+			t.Errorf("FindParams.Map() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestBuildItemFilterParams(t *testing.T) {
+	t.Parallel()
+	filters := []ItemFilter{
+		{Name: "ListingType", Values: []string{"FixedPrice"}},
+		{Name: "FreeShippingOnly", Values: []string{"true"}},
+		{Name: "LocatedIn", Values: []string{"US"}},
+		{Name: "MaxPrice", Values: []string{"100.00"}, ParamName: "Currency", ParamValue: "USD"},
+	}
+	want := map[string]string{
+		"itemFilter(0).name":       "ListingType",
+		"itemFilter(0).value(0)":   "FixedPrice",
+		"itemFilter(1).name":       "FreeShippingOnly",
+		"itemFilter(1).value(0)":   "true",
+		"itemFilter(2).name":       "LocatedIn",
+		"itemFilter(2).value(0)":   "US",
+		"itemFilter(3).name":       "MaxPrice",
+		"itemFilter(3).value(0)":   "100.00",
+		"itemFilter(3).paramName":  "Currency",
+		"itemFilter(3).paramValue": "USD",
+	}
+	if got := BuildItemFilterParams(filters); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildItemFilterParams() = %v, want %v", got, want)
+	}
+	if got := BuildItemFilterParams(nil); len(got) != 0 {
+		t.Errorf("BuildItemFilterParams(nil) = %v, want empty map", got)
+	}
+}
+
+func TestBuildAspectFilterParams(t *testing.T) {
+	t.Parallel()
+	t.Run("MultipleAspects", func(t *testing.T) {
+		t.Parallel()
+		filters := []AspectFilter{
+			{AspectName: "Brand", AspectValueNames: []string{"Apple"}},
+			{AspectName: "Color", AspectValueNames: []string{"Black", "Blue"}},
+			{AspectName: "Size", AspectValueNames: []string{"Large"}},
+		}
+		want := map[string]string{
+			"aspectFilter(0).aspectName":         "Brand",
+			"aspectFilter(0).aspectValueName(0)": "Apple",
+			"aspectFilter(1).aspectName":         "Color",
+			"aspectFilter(1).aspectValueName(0)": "Black",
+			"aspectFilter(1).aspectValueName(1)": "Blue",
+			"aspectFilter(2).aspectName":         "Size",
+			"aspectFilter(2).aspectValueName(0)": "Large",
+		}
+		got, err := BuildAspectFilterParams(filters)
+		if err != nil {
+			t.Fatalf("BuildAspectFilterParams() error = %v, want nil", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("BuildAspectFilterParams() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("InvalidAspectFilterError", func(t *testing.T) {
+		t.Parallel()
+		for _, f := range []AspectFilter{
+			{AspectName: "", AspectValueNames: []string{"Apple"}},
+			{AspectName: " ", AspectValueNames: []string{"Apple"}},
+			{AspectName: "Brand"},
+			{AspectName: "Brand", AspectValueNames: []string{""}},
+			{AspectName: "Brand", AspectValueNames: []string{"Apple", " "}},
+		} {
+			_, err := BuildAspectFilterParams([]AspectFilter{f})
+			if !errors.Is(err, ErrInvalidAspectFilter) {
+				t.Errorf("BuildAspectFilterParams(%v) error = %v, want %v", f, err, ErrInvalidAspectFilter)
+			}
+		}
+	})
+}
+
+func TestFindingClient_AspectFilterValidation(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name   string
+		Params map[string]string
+		Err    error
+	}{
+		{
+			Name:   "Valid",
+			Params: map[string]string{"aspectFilter(0).aspectName": "Brand", "aspectFilter(0).aspectValueName(0)": "Apple"},
+		},
+		{
+			Name:   "MissingAspectName",
+			Params: map[string]string{"aspectFilter(0).aspectValueName(0)": "Apple"},
+			Err:    ErrInvalidAspectFilter,
+		},
+		{
+			Name:   "MissingAspectValueName",
+			Params: map[string]string{"aspectFilter(0).aspectName": "Brand"},
+			Err:    ErrInvalidAspectFilter,
+		},
+		{
+			Name:   "EmptyAspectValueName",
+			Params: map[string]string{"aspectFilter(0).aspectName": "Brand", "aspectFilter(0).aspectValueName(0)": ""},
+			Err:    ErrInvalidAspectFilter,
+		},
+		{
+			Name:   "FindParamsMap",
+			Params: new(FindParams).AddAspectFilter("", nil).Map(),
+			Err:    ErrInvalidAspectFilter,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+			_, err := client.BuildRequestURL(context.Background(), OperationByKeywords, tc.Params)
+			if !errors.Is(err, tc.Err) {
+				t.Errorf("FindingClient.BuildRequestURL() error = %v, want %v", err, tc.Err)
+			}
+		})
+	}
+}
+
+func TestBuildAspectFilterParams_MaxAspectFilters(t *testing.T) {
+	t.Parallel()
+	filters := make([]AspectFilter, MaxAspectFilters+1)
+	for i := range filters {
+		filters[i] = AspectFilter{AspectName: fmt.Sprintf("Aspect%d", i), AspectValueNames: []string{"Value"}}
+	}
+	if _, err := BuildAspectFilterParams(filters[:MaxAspectFilters]); err != nil {
+		t.Errorf("BuildAspectFilterParams() error = %v, want nil", err)
+	}
+	_, err := BuildAspectFilterParams(filters)
+	if !errors.Is(err, ErrMaxAspectFilters) {
+		t.Errorf("BuildAspectFilterParams() error = %v, want %v", err, ErrMaxAspectFilters)
+	}
+	var p FindParams
+	p.AspectFilters = filters
+	client := NewFindingClient(http.DefaultClient, "ebay-app-id")
+	_, err = client.BuildRequestURL(context.Background(), OperationByKeywords, p.Map())
+	if !errors.Is(err, ErrMaxAspectFilters) {
+		t.Errorf("FindingClient.BuildRequestURL() error = %v, want %v", err, ErrMaxAspectFilters)
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name   string
+		Op     Operation
+		Params map[string]string
+		Err    error
+	}{
+		{Name: "AdvancedKeywords", Op: OperationAdvanced, Params: map[string]string{"keywords": "iphone"}},
+		{Name: "AdvancedCategoryID", Op: OperationAdvanced, Params: map[string]string{"categoryId(0)": "9355"}},
+		{Name: "AdvancedMissing", Op: OperationAdvanced, Params: map[string]string{}, Err: ErrMissingRequiredParam},
+		{Name: "ByCategory", Op: OperationByCategory, Params: map[string]string{"categoryId": "9355"}},
+		{
+			Name:   "ByCategoryMissing",
+			Op:     OperationByCategory,
+			Params: map[string]string{"keywords": "iphone"},
+			Err:    ErrMissingRequiredParam,
+		},
+		{Name: "ByKeywords", Op: OperationByKeywords, Params: map[string]string{"keywords": "iphone"}},
+		{
+			Name:   "ByKeywordsBlank",
+			Op:     OperationByKeywords,
+			Params: map[string]string{"keywords": " "},
+			Err:    ErrMissingRequiredParam,
+		},
+		{
+			Name:   "ByProduct",
+			Op:     OperationByProduct,
+			Params: map[string]string{"productId": "53039031", "productId.@type": "ReferenceID"},
+		},
+		{
+			Name:   "ByProductMissing",
+			Op:     OperationByProduct,
+			Params: map[string]string{"productId.@type": "ReferenceID"},
+			Err:    ErrMissingRequiredParam,
+		},
+		{Name: "InStores", Op: OperationInStores, Params: map[string]string{"storeName": "Example Store"}},
+		{Name: "InStoresMissing", Op: OperationInStores, Params: map[string]string{}, Err: ErrMissingRequiredParam},
+		{
+			Name:   "InvalidPageNumber",
+			Op:     OperationByKeywords,
+			Params: map[string]string{"keywords": "iphone", paramPageNumber: "0"},
+			Err:    ErrInvalidPageNumber,
+		},
+		{
+			Name:   "UnsupportedOperation",
+			Op:     Operation("findItemsByColor"),
+			Params: map[string]string{"keywords": "iphone"},
+			Err:    ErrUnsupportedOperation,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if err := ValidateParams(tc.Op, tc.Params); !errors.Is(err, tc.Err) {
+				t.Errorf("ValidateParams(%s) error = %v, want %v", tc.Op, err, tc.Err)
+			}
+		})
+	}
+}
+
+func TestParamsFromValues(t *testing.T) {
+	t.Parallel()
+	t.Run("SingleValued", func(t *testing.T) {
+		t.Parallel()
+		values := url.Values{
+			"keywords":               {"iphone"},
+			"itemFilter(0).name":     {"MaxPrice"},
+			"itemFilter(0).value(0)": {"500.0"},
+			"outputSelector":         {},
+		}
+		got, err := ParamsFromValues(values)
+		if err != nil {
+			t.Fatalf("ParamsFromValues() error = %v, want nil", err)
+		}
+		want := map[string]string{
+			"keywords":               "iphone",
+			"itemFilter(0).name":     "MaxPrice",
+			"itemFilter(0).value(0)": "500.0",
+			"outputSelector":         "",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ParamsFromValues() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("MultiValuedParamError", func(t *testing.T) {
+		t.Parallel()
+		values := url.Values{"keywords": {"iphone"}, "itemFilter.value": {"1000", "1500"}}
+		_, err := ParamsFromValues(values)
+		if !errors.Is(err, ErrMultiValuedParam) {
+			t.Errorf("ParamsFromValues() error = %v, want %v", err, ErrMultiValuedParam)
+		}
+	})
+}
+
+func TestEscapeStoreName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		Name string
+		In   string
+		Want string
+	}{
+		{Name: "BareAmpersand", In: "A & B", Want: "A &amp; B"},
+		{Name: "AlreadyEscaped", In: "A &amp; B", Want: "A &amp; B"},
+		{Name: "Mixed", In: "A&B &amp; C&", Want: "A&amp;B &amp; C&amp;"},
+		{Name: "CharacterReference", In: "A &#38; B", Want: "A &#38; B"},
+		{Name: "HexCharacterReference", In: "A &#x26; B", Want: "A &#x26; B"},
+		{Name: "UnknownEntity", In: "Tom&Jerry;", Want: "Tom&amp;Jerry;"},
+		{Name: "NoAmpersand", In: "Supplytronics", Want: "Supplytronics"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+			got := EscapeStoreName(tt.In)
+			if got != tt.Want {
+				t.Errorf("EscapeStoreName(%q) = %q, want %q", tt.In, got, tt.Want)
+			}
+			if again := EscapeStoreName(got); again != got {
+				t.Errorf("EscapeStoreName(%q) = %q, want %q", got, again, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/matthewdargan/ebay/internal/testutil"
+)
+
+func TestFindingClient_Replay(t *testing.T) {
+	t.Parallel()
+	path := testutil.GoldenPath("testdata", OperationByKeywords.String())
+	client := NewFindingClient(&http.Client{Transport: &testutil.Replayer{Path: path}}, "ebay-app-id")
+	got, err := client.FindItemsByKeywords(context.Background(), map[string]string{"keywords": "iphone"})
+	if err != nil {
+		t.Fatalf("FindingClient.FindItemsByKeywords() error = %v, want nil", err)
+	}
+	res := got.ItemsResponse[0]
+	if ack := res.AckValue(); ack != AckSuccess {
+		t.Errorf("FindItemsResponse.AckValue() = %q, want %q", ack, AckSuccess)
+	}
+	if n := res.ItemCount(); n != 2 {
+		t.Fatalf("FindItemsResponse.ItemCount() = %d, want 2", n)
+	}
+	items := res.Items()
+	wantIDs := []string{"123456789012", "234567890123"}
+	for i, item := range items {
+		if id := item.ItemID[0]; id != wantIDs[i] {
+			t.Errorf("items[%d].ItemID = %s, want %s", i, id, wantIDs[i])
+		}
+	}
+	price, ok := items[1].CurrentPrice()
+	if !ok || price.Value != "399.99" || price.CurrencyID != "USD" {
+		t.Errorf("items[1].CurrentPrice() = %v, %t, want 399.99 USD", price, ok)
+	}
+	if lt := items[1].ListingInfo[0].Type(); lt != "FixedPrice" {
+		t.Errorf("items[1].ListingInfo.Type() = %q, want FixedPrice", lt)
+	}
+}
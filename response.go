@@ -3,33 +3,117 @@
 
 package ebay
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	unitKilometers       = "km"
+	unitMiles            = "mi"
+	kilometersPerMi      = 1.609344
+	errorCategorySystem  = "System"
+	errorCategoryRequest = "Request"
+	severityError        = "Error"
+	severityWarning      = "Warning"
+	pricingTreatmentNone = "NONE"
+)
+
+// affiliateQueryParams are the eBay Partner Network tracking params eBay adds to the
+// view item URL when the request includes affiliate params.
+var affiliateQueryParams = []string{"campid", "customid", "mkcid", "mkevt", "mkrid", "toolid"}
+
+var (
+	// ErrInvalidDuration is returned when an ISO 8601 duration returned by eBay cannot be parsed.
+	ErrInvalidDuration = errors.New("ebay: invalid ISO 8601 duration")
+
+	// ErrInvalidPrice is returned when a price returned by eBay is missing or cannot be parsed.
+	ErrInvalidPrice = errors.New("ebay: invalid price")
+
+	// ErrCurrencyMismatch is returned when prices in different currencies are combined.
+	ErrCurrencyMismatch = errors.New("ebay: prices have different currencies")
+
+	// ErrInvalidDistance is returned when a distance returned by eBay cannot be parsed
+	// or has an unknown unit.
+	ErrInvalidDistance = errors.New("ebay: invalid distance")
+
+	// ErrInvalidErrorID is returned when an error ID returned by eBay is missing or cannot be parsed.
+	ErrInvalidErrorID = errors.New("ebay: invalid error ID")
+
+	// ErrInvalidFeedback is returned when seller feedback returned by eBay is missing or cannot be parsed.
+	ErrInvalidFeedback = errors.New("ebay: invalid seller feedback")
+
+	// ErrInvalidViewURL is returned when an item's view URL returned by eBay is missing or cannot be parsed.
+	ErrInvalidViewURL = errors.New("ebay: invalid view item URL")
+
+	// ErrNoAffiliateParams is returned when an item's view URL has no affiliate tracking params.
+	ErrNoAffiliateParams = errors.New("ebay: view item URL has no affiliate tracking params")
+
+	// ErrInvalidWatchCount is returned when a watch count returned by eBay cannot be parsed.
+	ErrInvalidWatchCount = errors.New("ebay: invalid watch count")
+)
+
+// ResultProvider is implemented by the responses of every eBay Finding API operation.
+type ResultProvider interface {
+	// Results returns the base response containers of the operation's response.
+	Results() []FindItemsResponse
+}
 
 // FindItemsAdvancedResponse represents the response from [FindingClient.FindItemsAdvanced].
 type FindItemsAdvancedResponse struct {
 	ItemsResponse []FindItemsResponse `json:"findItemsAdvancedResponse"`
 }
 
+// Results returns the base response containers of r.
+func (r *FindItemsAdvancedResponse) Results() []FindItemsResponse {
+	return r.ItemsResponse
+}
+
 // FindItemsByCategoryResponse represents the response from [FindingClient.FindItemsByCategory].
 type FindItemsByCategoryResponse struct {
 	ItemsResponse []FindItemsResponse `json:"findItemsByCategoryResponse"`
 }
 
+// Results returns the base response containers of r.
+func (r *FindItemsByCategoryResponse) Results() []FindItemsResponse {
+	return r.ItemsResponse
+}
+
 // FindItemsByKeywordsResponse represents the response from [FindingClient.FindItemsByKeywords].
 type FindItemsByKeywordsResponse struct {
 	ItemsResponse []FindItemsResponse `json:"findItemsByKeywordsResponse"`
 }
 
+// Results returns the base response containers of r.
+func (r *FindItemsByKeywordsResponse) Results() []FindItemsResponse {
+	return r.ItemsResponse
+}
+
 // FindItemsByProductResponse represents the response from [FindingClient.FindItemsByProduct].
 type FindItemsByProductResponse struct {
 	ItemsResponse []FindItemsResponse `json:"findItemsByProductResponse"`
 }
 
+// Results returns the base response containers of r.
+func (r *FindItemsByProductResponse) Results() []FindItemsResponse {
+	return r.ItemsResponse
+}
+
 // FindItemsInEBayStoresResponse represents the response from [FindingClient.FindItemsInEBayStores].
 type FindItemsInEBayStoresResponse struct {
 	ItemsResponse []FindItemsResponse `json:"findItemsIneBayStoresResponse"`
 }
 
+// Results returns the base response containers of r.
+func (r *FindItemsInEBayStoresResponse) Results() []FindItemsResponse {
+	return r.ItemsResponse
+}
+
 // FindItemsResponse represents the base response container for all Finding Service operations.
 //
 // See [BaseServiceResponse] for details about generic response fields.
@@ -47,6 +131,92 @@ type FindItemsResponse struct {
 	Version          []string           `json:"version"`
 }
 
+// Ack is the acknowledgement of an eBay Finding API response, indicating whether
+// the request succeeded. See https://developer.ebay.com/Devzone/finding/CallRef/Enums/AckValue.html.
+type Ack string
+
+// The acknowledgement values returned by the eBay Finding API.
+const (
+	AckUnknown        Ack = ""
+	AckSuccess        Ack = "Success"
+	AckWarning        Ack = "Warning"
+	AckFailure        Ack = "Failure"
+	AckPartialFailure Ack = "PartialFailure"
+)
+
+// AckValue returns the acknowledgement of r.
+// It returns [AckUnknown] if the ack is missing or not a known value.
+func (r FindItemsResponse) AckValue() Ack {
+	if len(r.Ack) == 0 {
+		return AckUnknown
+	}
+	switch a := Ack(r.Ack[0]); a {
+	case AckSuccess, AckWarning, AckFailure, AckPartialFailure:
+		return a
+	}
+	return AckUnknown
+}
+
+// HasErrors reports whether eBay failed to fully process the request of r,
+// either because its ack is Failure or PartialFailure or because it has errors.
+func (r FindItemsResponse) HasErrors() bool {
+	switch r.AckValue() {
+	case AckFailure, AckPartialFailure:
+		return true
+	case AckSuccess:
+		return false
+	}
+	return len(r.Errors()) > 0
+}
+
+// Errors returns the error details in r whose severity is Error.
+// It returns nil if r has no errors.
+func (r FindItemsResponse) Errors() []ErrorData {
+	return r.errorsWithSeverity(severityError)
+}
+
+// Warnings returns the error details in r whose severity is Warning.
+// Warnings report non-fatal issues, such as an ignored item filter.
+// It returns nil if r has no warnings.
+func (r FindItemsResponse) Warnings() []ErrorData {
+	return r.errorsWithSeverity(severityWarning)
+}
+
+func (r FindItemsResponse) errorsWithSeverity(severity string) []ErrorData {
+	var details []ErrorData
+	for _, m := range r.ErrorMessage {
+		for _, e := range m.Error {
+			if len(e.Severity) > 0 && e.Severity[0] == severity {
+				details = append(details, e)
+			}
+		}
+	}
+	return details
+}
+
+// ItemCount returns the number of items in the search result of r, as reported by its count.
+// It returns 0 if r has no search result, such as when no items match the search.
+func (r FindItemsResponse) ItemCount() int {
+	if len(r.SearchResult) == 0 {
+		return 0
+	}
+	n, err := strconv.Atoi(r.SearchResult[0].Count)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// Items returns the items in the search results of r.
+// It returns nil if r has no search result or no items.
+func (r FindItemsResponse) Items() []SearchItem {
+	var items []SearchItem
+	for _, sr := range r.SearchResult {
+		items = append(items, sr.Item...)
+	}
+	return items
+}
+
 // ErrorMessage is a message containing information regarding an error or warning that occurred
 // when eBay processed the request. It is not returned when the ack value is Success.
 // See https://developer.ebay.com/Devzone/finding/CallRef/types/ErrorMessage.html.
@@ -67,6 +237,31 @@ type ErrorData struct {
 	Subdomain   []string `json:"subdomain"`
 }
 
+// ErrorIDInt returns the numeric ID of the error.
+// It returns [ErrInvalidErrorID] if the ID is missing or cannot be parsed.
+func (e ErrorData) ErrorIDInt() (int, error) {
+	if len(e.ErrorID) == 0 {
+		return 0, fmt.Errorf("%w: missing error ID", ErrInvalidErrorID)
+	}
+	n, err := strconv.Atoi(e.ErrorID[0])
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidErrorID, e.ErrorID[0])
+	}
+	return n, nil
+}
+
+// IsSystemError reports whether the error is a System error, indicating a problem on
+// eBay's side that is usually transient, so the request may succeed if retried.
+func (e ErrorData) IsSystemError() bool {
+	return len(e.Category) > 0 && e.Category[0] == errorCategorySystem
+}
+
+// IsRequestError reports whether the error is a Request error, indicating a problem with
+// the request, such as an invalid param, that must be fixed before retrying.
+func (e ErrorData) IsRequestError() bool {
+	return len(e.Category) > 0 && e.Category[0] == errorCategoryRequest
+}
+
 // PaginationOutput represents the pagination data for an item search.
 // See https://developer.ebay.com/Devzone/finding/CallRef/types/PaginationOutput.html.
 type PaginationOutput struct {
@@ -122,6 +317,152 @@ type SearchItem struct {
 	ViewItemURL             []string            `json:"viewItemURL"`
 }
 
+// IsMultiVariation reports whether the item is a multi-variation listing.
+func (i SearchItem) IsMultiVariation() bool {
+	return firstBool(i.IsMultiVariationListing)
+}
+
+// TopRated reports whether the item is a Top Rated Plus listing.
+func (i SearchItem) TopRated() bool {
+	return firstBool(i.TopRatedListing)
+}
+
+// AutoPayEnabled reports whether the seller requires immediate payment for the item.
+func (i SearchItem) AutoPayEnabled() bool {
+	return firstBool(i.AutoPay)
+}
+
+// GalleryURLBySize returns the URL of the gallery image with the given size, such as Small,
+// Medium, or Large. The boolean result reports whether an image with that size was found.
+func (i SearchItem) GalleryURLBySize(size string) (string, bool) {
+	for _, g := range i.GalleryInfoContainer {
+		if g.GallerySize == size {
+			return g.Value, true
+		}
+	}
+	return "", false
+}
+
+// PrimaryImageURL returns the URL of the largest available image of the item,
+// preferring PictureURLSuperSize, then PictureURLLarge, then GalleryURL.
+// It returns an empty string if the item has no images.
+func (i SearchItem) PrimaryImageURL() string {
+	for _, urls := range [][]string{i.PictureURLSuperSize, i.PictureURLLarge, i.GalleryURL} {
+		if len(urls) > 0 && urls[0] != "" {
+			return urls[0]
+		}
+	}
+	return ""
+}
+
+// DistanceValue returns the numeric distance of the item from the buyer and its unit,
+// mi or km, from the first distance entry. It reports false if the item has no distance
+// or the distance cannot be parsed.
+func (i SearchItem) DistanceValue() (float64, string, bool) {
+	if len(i.Distance) == 0 {
+		return 0, "", false
+	}
+	d := i.Distance[0]
+	v, err := d.Float64()
+	if err != nil {
+		return 0, "", false
+	}
+	return v, d.Unit, true
+}
+
+// ViewURL returns the parsed URL of the item's listing page.
+// It returns [ErrInvalidViewURL] if the URL is missing or cannot be parsed.
+func (i SearchItem) ViewURL() (*url.URL, error) {
+	if len(i.ViewItemURL) == 0 || i.ViewItemURL[0] == "" {
+		return nil, fmt.Errorf("%w: missing view item URL", ErrInvalidViewURL)
+	}
+	u, err := url.Parse(i.ViewItemURL[0])
+	if err != nil || !u.IsAbs() {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidViewURL, i.ViewItemURL[0])
+	}
+	return u, nil
+}
+
+// AffiliateViewURL returns the parsed URL of the item's listing page if it carries the
+// eBay Partner Network tracking params eBay adds for requests with affiliate params.
+// It returns [ErrInvalidViewURL] if the URL is missing or cannot be parsed, and
+// [ErrNoAffiliateParams] if it has no affiliate tracking params.
+func (i SearchItem) AffiliateViewURL() (*url.URL, error) {
+	u, err := i.ViewURL()
+	if err != nil {
+		return nil, err
+	}
+	qry := u.Query()
+	if !slices.ContainsFunc(affiliateQueryParams, qry.Has) {
+		return nil, fmt.Errorf("%w: %q", ErrNoAffiliateParams, u)
+	}
+	return u, nil
+}
+
+// CurrentPrice returns the current price of the item in the currency of the site it is listed on.
+// The boolean result reports whether the price is available.
+func (i SearchItem) CurrentPrice() (Price, bool) {
+	if len(i.SellingStatus) == 0 || len(i.SellingStatus[0].CurrentPrice) == 0 {
+		return Price{}, false
+	}
+	return i.SellingStatus[0].CurrentPrice[0], true
+}
+
+// OriginalRetailPrice returns the original retail price of a discounted item, against
+// which its current price is compared. The boolean result reports whether the price is available.
+func (i SearchItem) OriginalRetailPrice() (Price, bool) {
+	if len(i.DiscountPriceInfo) == 0 || len(i.DiscountPriceInfo[0].OriginalRetailPrice) == 0 {
+		return Price{}, false
+	}
+	return i.DiscountPriceInfo[0].OriginalRetailPrice[0], true
+}
+
+// IsOnSale reports whether the item has a discount pricing treatment, such as STP
+// (strikethrough pricing) or MAP (minimum advertised price).
+func (i SearchItem) IsOnSale() bool {
+	if len(i.DiscountPriceInfo) == 0 || len(i.DiscountPriceInfo[0].PricingTreatment) == 0 {
+		return false
+	}
+	t := i.DiscountPriceInfo[0].PricingTreatment[0]
+	return t != "" && t != pricingTreatmentNone
+}
+
+// ShippingCost returns the cost of shipping the item to the buyer.
+// The boolean result reports whether the cost is available.
+func (i SearchItem) ShippingCost() (Price, bool) {
+	if len(i.ShippingInfo) == 0 || len(i.ShippingInfo[0].ShippingServiceCost) == 0 {
+		return Price{}, false
+	}
+	return i.ShippingInfo[0].ShippingServiceCost[0], true
+}
+
+// TotalPrice returns the current price of the item plus its shipping cost, and their currency.
+// If the shipping cost is not available, TotalPrice returns the current price alone.
+// It returns [ErrInvalidPrice] if the current price is not available or either amount
+// is malformed, and [ErrCurrencyMismatch] if the amounts are in different currencies.
+func (i SearchItem) TotalPrice() (float64, string, error) {
+	price, ok := i.CurrentPrice()
+	if !ok {
+		return 0, "", fmt.Errorf("%w: missing current price", ErrInvalidPrice)
+	}
+	total, err := strconv.ParseFloat(price.Value, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: %q", ErrInvalidPrice, price.Value)
+	}
+	shipping, ok := i.ShippingCost()
+	if !ok {
+		return total, price.CurrencyID, nil
+	}
+	if shipping.CurrencyID != price.CurrencyID {
+		return 0, "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, price.CurrencyID, shipping.CurrencyID)
+	}
+	cost, err := strconv.ParseFloat(shipping.Value, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: %q", ErrInvalidPrice, shipping.Value)
+	}
+	return total + cost, price.CurrencyID, nil
+}
+
 // Condition describes an item's condition.
 // See https://developer.ebay.com/Devzone/finding/CallRef/types/Condition.html.
 type Condition struct {
@@ -157,6 +498,48 @@ type Distance struct {
 	Value string `json:"__value__"`
 }
 
+// Float64 returns the numeric value of d in its reported unit.
+// It returns [ErrInvalidDistance] if the value cannot be parsed.
+func (d Distance) Float64() (float64, error) {
+	v, err := strconv.ParseFloat(d.Value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDistance, d.Value)
+	}
+	return v, nil
+}
+
+// Kilometers returns d in kilometers, converting from miles if needed.
+// It returns [ErrInvalidDistance] if the value cannot be parsed or the unit is unknown.
+func (d Distance) Kilometers() (float64, error) {
+	v, err := d.Float64()
+	if err != nil {
+		return 0, err
+	}
+	switch d.Unit {
+	case unitKilometers:
+		return v, nil
+	case unitMiles:
+		return v * kilometersPerMi, nil
+	}
+	return 0, fmt.Errorf("%w: unknown unit %q", ErrInvalidDistance, d.Unit)
+}
+
+// Miles returns d in miles, converting from kilometers if needed.
+// It returns [ErrInvalidDistance] if the value cannot be parsed or the unit is unknown.
+func (d Distance) Miles() (float64, error) {
+	v, err := d.Float64()
+	if err != nil {
+		return 0, err
+	}
+	switch d.Unit {
+	case unitMiles:
+		return v, nil
+	case unitKilometers:
+		return v / kilometersPerMi, nil
+	}
+	return 0, fmt.Errorf("%w: unknown unit %q", ErrInvalidDistance, d.Unit)
+}
+
 // GalleryURL is the URL for the Gallery thumbnail image.
 // This value is only returned if the seller uploaded images for the item or
 // the item was listed using a product identifier.
@@ -179,6 +562,44 @@ type ListingInfo struct {
 	WatchCount             []string    `json:"watchCount"`
 }
 
+// IsBestOfferEnabled reports whether the seller accepts Best Offers for the listing.
+func (l ListingInfo) IsBestOfferEnabled() bool {
+	return firstBool(l.BestOfferEnabled)
+}
+
+// IsBuyItNowAvailable reports whether the auction listing can be bought with Buy It Now.
+func (l ListingInfo) IsBuyItNowAvailable() bool {
+	return firstBool(l.BuyItNowAvailable)
+}
+
+// IsGift reports whether the seller offers the listing as a gift.
+func (l ListingInfo) IsGift() bool {
+	return firstBool(l.Gift)
+}
+
+// Type returns the format of the listing, such as Auction or FixedPrice.
+// It returns an empty string if the listing type is missing.
+func (l ListingInfo) Type() string {
+	if len(l.ListingType) == 0 {
+		return ""
+	}
+	return l.ListingType[0]
+}
+
+// WatchCountInt returns the number of watchers of the listing.
+// It returns 0 if the watch count is missing, which eBay does for unwatched listings,
+// and [ErrInvalidWatchCount] if it cannot be parsed.
+func (l ListingInfo) WatchCountInt() (int, error) {
+	if len(l.WatchCount) == 0 {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(l.WatchCount[0])
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidWatchCount, l.WatchCount[0])
+	}
+	return n, nil
+}
+
 // Category represents details about a category.
 // See https://developer.ebay.com/Devzone/finding/CallRef/types/Category.html.
 type Category struct {
@@ -203,6 +624,37 @@ type SellerInfo struct {
 	TopRatedSeller          []string `json:"topRatedSeller"`
 }
 
+// FeedbackScoreInt returns the seller's feedback score.
+// It returns [ErrInvalidFeedback] if the score is missing or cannot be parsed.
+func (s SellerInfo) FeedbackScoreInt() (int, error) {
+	if len(s.FeedbackScore) == 0 {
+		return 0, fmt.Errorf("%w: missing feedback score", ErrInvalidFeedback)
+	}
+	n, err := strconv.Atoi(s.FeedbackScore[0])
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidFeedback, s.FeedbackScore[0])
+	}
+	return n, nil
+}
+
+// PositiveFeedbackFloat returns the percentage of the seller's feedback that is positive,
+// such as 99.8. It returns [ErrInvalidFeedback] if the percentage is missing or cannot be parsed.
+func (s SellerInfo) PositiveFeedbackFloat() (float64, error) {
+	if len(s.PositiveFeedbackPercent) == 0 {
+		return 0, fmt.Errorf("%w: missing positive feedback percent", ErrInvalidFeedback)
+	}
+	v, err := strconv.ParseFloat(s.PositiveFeedbackPercent[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidFeedback, s.PositiveFeedbackPercent[0])
+	}
+	return v, nil
+}
+
+// IsTopRated reports whether the seller is a Top Rated Seller.
+func (s SellerInfo) IsTopRated() bool {
+	return firstBool(s.TopRatedSeller)
+}
+
 // SellingStatus represents an item's selling details.
 // See https://developer.ebay.com/Devzone/finding/CallRef/types/SellingStatus.html.
 type SellingStatus struct {
@@ -213,6 +665,17 @@ type SellingStatus struct {
 	TimeLeft              []string `json:"timeLeft"`
 }
 
+// TimeLeftDuration parses the time left before the listing ends, an ISO 8601 duration
+// such as P1DT5H30M, into a [time.Duration]. Only day, hour, minute, and second
+// components are supported, as those are the only ones eBay returns.
+// It returns [ErrInvalidDuration] if TimeLeft is empty or malformed.
+func (s SellingStatus) TimeLeftDuration() (time.Duration, error) {
+	if len(s.TimeLeft) == 0 {
+		return 0, fmt.Errorf("%w: missing time left", ErrInvalidDuration)
+	}
+	return parseDuration(s.TimeLeft[0])
+}
+
 // ShippingInfo represents an item's shipping details.
 // See https://developer.ebay.com/Devzone/finding/CallRef/types/ShippingInfo.html.
 type ShippingInfo struct {
@@ -238,3 +701,65 @@ type UnitPriceInfo struct {
 	Quantity []string `json:"quantity"`
 	Type     []string `json:"type"`
 }
+
+// firstBool reports whether the first element of s is a true boolean value.
+// It returns false if s is empty or its first element is not a boolean.
+func firstBool(s []string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	b, err := strconv.ParseBool(s[0])
+	return err == nil && b
+}
+
+// parseDuration parses an ISO 8601 duration with day, hour, minute, and second components.
+func parseDuration(v string) (time.Duration, error) {
+	rest, ok := strings.CutPrefix(v, "P")
+	if !ok || rest == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, v)
+	}
+	var (
+		d        time.Duration
+		inTime   bool
+		units    = "D"
+		hasValue bool
+	)
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, v)
+			}
+			inTime, units, rest = true, "HMS", rest[1:]
+			continue
+		}
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i <= 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, v)
+		}
+		n, err := strconv.ParseFloat(rest[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, v)
+		}
+		unit := strings.IndexByte(units, rest[i])
+		if unit < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, v)
+		}
+		var scale time.Duration
+		switch units[unit] {
+		case 'D':
+			scale = 24 * time.Hour
+		case 'H':
+			scale = time.Hour
+		case 'M':
+			scale = time.Minute
+		case 'S':
+			scale = time.Second
+		}
+		d += time.Duration(n * float64(scale))
+		units, rest, hasValue = units[unit+1:], rest[i+1:], true
+	}
+	if !hasValue {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, v)
+	}
+	return d, nil
+}
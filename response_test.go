@@ -0,0 +1,644 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSearchItem_Flags(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name   string
+		Values []string
+		Want   bool
+	}{
+		{Name: "True", Values: []string{"true"}, Want: true},
+		{Name: "False", Values: []string{"false"}, Want: false},
+		{Name: "Empty", Values: nil, Want: false},
+		{Name: "Invalid", Values: []string{"yes"}, Want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			item := SearchItem{
+				IsMultiVariationListing: tc.Values,
+				TopRatedListing:         tc.Values,
+				AutoPay:                 tc.Values,
+			}
+			if got := item.IsMultiVariation(); got != tc.Want {
+				t.Errorf("SearchItem.IsMultiVariation() = %t, want %t", got, tc.Want)
+			}
+			if got := item.TopRated(); got != tc.Want {
+				t.Errorf("SearchItem.TopRated() = %t, want %t", got, tc.Want)
+			}
+			if got := item.AutoPayEnabled(); got != tc.Want {
+				t.Errorf("SearchItem.AutoPayEnabled() = %t, want %t", got, tc.Want)
+			}
+		})
+	}
+}
+
+func TestListingInfo_Accessors(t *testing.T) {
+	t.Parallel()
+	t.Run("Flags", func(t *testing.T) {
+		t.Parallel()
+		l := ListingInfo{
+			BestOfferEnabled:  []string{"true"},
+			BuyItNowAvailable: []string{"false"},
+			Gift:              []string{"true"},
+			ListingType:       []string{"AuctionWithBIN"},
+		}
+		if !l.IsBestOfferEnabled() {
+			t.Error("ListingInfo.IsBestOfferEnabled() = false, want true")
+		}
+		if l.IsBuyItNowAvailable() {
+			t.Error("ListingInfo.IsBuyItNowAvailable() = true, want false")
+		}
+		if !l.IsGift() {
+			t.Error("ListingInfo.IsGift() = false, want true")
+		}
+		if got := l.Type(); got != "AuctionWithBIN" {
+			t.Errorf("ListingInfo.Type() = %q, want AuctionWithBIN", got)
+		}
+		var empty ListingInfo
+		if empty.IsBestOfferEnabled() || empty.IsBuyItNowAvailable() || empty.IsGift() || empty.Type() != "" {
+			t.Errorf("ListingInfo{} accessors = non-zero, want zero values")
+		}
+	})
+
+	t.Run("WatchCountInt", func(t *testing.T) {
+		t.Parallel()
+		testCases := []struct {
+			Name   string
+			Values []string
+			Want   int
+			Err    error
+		}{
+			{Name: "Valid", Values: []string{"42"}, Want: 42},
+			{Name: "Missing", Values: nil, Want: 0},
+			{Name: "Invalid", Values: []string{"many"}, Err: ErrInvalidWatchCount},
+		}
+		for _, tc := range testCases {
+			l := ListingInfo{WatchCount: tc.Values}
+			got, err := l.WatchCountInt()
+			if !errors.Is(err, tc.Err) {
+				t.Errorf("ListingInfo.WatchCountInt() %s error = %v, want %v", tc.Name, err, tc.Err)
+			}
+			if got != tc.Want {
+				t.Errorf("ListingInfo.WatchCountInt() %s = %d, want %d", tc.Name, got, tc.Want)
+			}
+		}
+	})
+}
+
+func TestSellingStatus_TimeLeftDuration(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name     string
+		TimeLeft []string
+		Want     time.Duration
+		Err      error
+	}{
+		{Name: "DaysHoursMinutes", TimeLeft: []string{"P1DT5H30M"}, Want: 29*time.Hour + 30*time.Minute},
+		{Name: "AllComponents", TimeLeft: []string{"P0DT0H3M25S"}, Want: 3*time.Minute + 25*time.Second},
+		{Name: "TimeOnly", TimeLeft: []string{"PT45S"}, Want: 45 * time.Second},
+		{Name: "DaysOnly", TimeLeft: []string{"P2D"}, Want: 48 * time.Hour},
+		{Name: "FractionalSeconds", TimeLeft: []string{"PT1.5S"}, Want: 1500 * time.Millisecond},
+		{Name: "Missing", TimeLeft: nil, Err: ErrInvalidDuration},
+		{Name: "Empty", TimeLeft: []string{""}, Err: ErrInvalidDuration},
+		{Name: "NoPrefix", TimeLeft: []string{"1DT5H"}, Err: ErrInvalidDuration},
+		{Name: "OnlyPrefix", TimeLeft: []string{"P"}, Err: ErrInvalidDuration},
+		{Name: "TrailingT", TimeLeft: []string{"P1DT"}, Err: ErrInvalidDuration},
+		{Name: "TimeUnitInDatePart", TimeLeft: []string{"P5H"}, Err: ErrInvalidDuration},
+		{Name: "OutOfOrder", TimeLeft: []string{"PT5M1H"}, Err: ErrInvalidDuration},
+		{Name: "MissingNumber", TimeLeft: []string{"PTH"}, Err: ErrInvalidDuration},
+		{Name: "Years", TimeLeft: []string{"P1Y"}, Err: ErrInvalidDuration},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SellingStatus{TimeLeft: tc.TimeLeft}.TimeLeftDuration()
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("SellingStatus.TimeLeftDuration() error = %v, want %v", err, tc.Err)
+			}
+			if got != tc.Want {
+				t.Errorf("SellingStatus.TimeLeftDuration() = %v, want %v", got, tc.Want)
+			}
+		})
+	}
+}
+
+func TestSearchItem_GalleryURLBySize(t *testing.T) {
+	t.Parallel()
+	item := SearchItem{GalleryInfoContainer: []GalleryURL{
+		{GallerySize: "Small", Value: "https://i.ebayimg.com/small.jpg"},
+		{GallerySize: "Large", Value: "https://i.ebayimg.com/large.jpg"},
+	}}
+	got, ok := item.GalleryURLBySize("Large")
+	if !ok || got != "https://i.ebayimg.com/large.jpg" {
+		t.Errorf("SearchItem.GalleryURLBySize(Large) = %q, %t, want %q, true", got, ok, "https://i.ebayimg.com/large.jpg")
+	}
+	if got, ok = item.GalleryURLBySize("Medium"); ok {
+		t.Errorf("SearchItem.GalleryURLBySize(Medium) = %q, true, want false", got)
+	}
+}
+
+func TestSearchItem_PrimaryImageURL(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name string
+		Item SearchItem
+		Want string
+	}{
+		{
+			Name: "SuperSize",
+			Item: SearchItem{PictureURLSuperSize: []string{"super"}, PictureURLLarge: []string{"large"}, GalleryURL: []string{"gallery"}},
+			Want: "super",
+		},
+		{Name: "Large", Item: SearchItem{PictureURLLarge: []string{"large"}, GalleryURL: []string{"gallery"}}, Want: "large"},
+		{Name: "Gallery", Item: SearchItem{GalleryURL: []string{"gallery"}}, Want: "gallery"},
+		{Name: "None", Item: SearchItem{}, Want: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.Item.PrimaryImageURL(); got != tc.Want {
+				t.Errorf("SearchItem.PrimaryImageURL() = %q, want %q", got, tc.Want)
+			}
+		})
+	}
+}
+
+func TestFindItemsResponse_ErrorsAndWarnings(t *testing.T) {
+	t.Parallel()
+	errData := ErrorData{ErrorID: []string{"3"}, Severity: []string{"Error"}}
+	warnData := ErrorData{ErrorID: []string{"12"}, Severity: []string{"Warning"}}
+	r := FindItemsResponse{
+		Ack: []string{"Warning"},
+		ErrorMessage: []ErrorMessage{
+			{Error: []ErrorData{warnData, errData}},
+			{Error: []ErrorData{{ErrorID: []string{"99"}}}},
+		},
+	}
+	if got, want := r.Errors(), []ErrorData{errData}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FindItemsResponse.Errors() = %v, want %v", got, want)
+	}
+	if got, want := r.Warnings(), []ErrorData{warnData}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FindItemsResponse.Warnings() = %v, want %v", got, want)
+	}
+	var empty FindItemsResponse
+	if got := empty.Errors(); got != nil {
+		t.Errorf("FindItemsResponse.Errors() = %v, want nil", got)
+	}
+	if got := empty.Warnings(); got != nil {
+		t.Errorf("FindItemsResponse.Warnings() = %v, want nil", got)
+	}
+}
+
+func TestFindItemsResponse_AckValue(t *testing.T) {
+	t.Parallel()
+	errMsg := []ErrorMessage{{Error: []ErrorData{{Severity: []string{"Error"}}}}}
+	testCases := []struct {
+		Name          string
+		Res           FindItemsResponse
+		Want          Ack
+		WantHasErrors bool
+	}{
+		{Name: "Success", Res: FindItemsResponse{Ack: []string{"Success"}}, Want: AckSuccess},
+		{Name: "Warning", Res: FindItemsResponse{Ack: []string{"Warning"}}, Want: AckWarning},
+		{Name: "WarningWithErrors", Res: FindItemsResponse{Ack: []string{"Warning"}, ErrorMessage: errMsg}, Want: AckWarning, WantHasErrors: true},
+		{Name: "Failure", Res: FindItemsResponse{Ack: []string{"Failure"}}, Want: AckFailure, WantHasErrors: true},
+		{Name: "PartialFailure", Res: FindItemsResponse{Ack: []string{"PartialFailure"}}, Want: AckPartialFailure, WantHasErrors: true},
+		{Name: "Missing", Res: FindItemsResponse{}, Want: AckUnknown},
+		{Name: "Unknown", Res: FindItemsResponse{Ack: []string{"Maybe"}}, Want: AckUnknown},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.Res.AckValue(); got != tc.Want {
+				t.Errorf("FindItemsResponse.AckValue() = %q, want %q", got, tc.Want)
+			}
+			if got := tc.Res.HasErrors(); got != tc.WantHasErrors {
+				t.Errorf("FindItemsResponse.HasErrors() = %t, want %t", got, tc.WantHasErrors)
+			}
+		})
+	}
+}
+
+func TestErrorData_Classify(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name        string
+		Data        ErrorData
+		WantID      int
+		Err         error
+		WantSystem  bool
+		WantRequest bool
+	}{
+		{Name: "System", Data: ErrorData{ErrorID: []string{"10001"}, Category: []string{"System"}}, WantID: 10001, WantSystem: true},
+		{Name: "Request", Data: ErrorData{ErrorID: []string{"3"}, Category: []string{"Request"}}, WantID: 3, WantRequest: true},
+		{Name: "Application", Data: ErrorData{ErrorID: []string{"12"}, Category: []string{"Application"}}, WantID: 12},
+		{Name: "MissingID", Data: ErrorData{}, Err: ErrInvalidErrorID},
+		{Name: "InvalidID", Data: ErrorData{ErrorID: []string{"abc"}}, Err: ErrInvalidErrorID},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			id, err := tc.Data.ErrorIDInt()
+			if !errors.Is(err, tc.Err) {
+				t.Errorf("ErrorData.ErrorIDInt() error = %v, want %v", err, tc.Err)
+			}
+			if id != tc.WantID {
+				t.Errorf("ErrorData.ErrorIDInt() = %d, want %d", id, tc.WantID)
+			}
+			if got := tc.Data.IsSystemError(); got != tc.WantSystem {
+				t.Errorf("ErrorData.IsSystemError() = %t, want %t", got, tc.WantSystem)
+			}
+			if got := tc.Data.IsRequestError(); got != tc.WantRequest {
+				t.Errorf("ErrorData.IsRequestError() = %t, want %t", got, tc.WantRequest)
+			}
+		})
+	}
+}
+
+func TestFindItemsResponse_Items(t *testing.T) {
+	t.Parallel()
+	items := []SearchItem{{ItemID: []string{"1"}}, {ItemID: []string{"2"}}}
+	tests := []struct {
+		Name      string
+		Res       FindItemsResponse
+		WantCount int
+		WantItems []SearchItem
+	}{
+		{Name: "MissingSearchResult", Res: FindItemsResponse{}},
+		{Name: "ZeroCount", Res: FindItemsResponse{SearchResult: []SearchResult{{Count: "0"}}}},
+		{
+			Name:      "Items",
+			Res:       FindItemsResponse{SearchResult: []SearchResult{{Count: "2", Item: items}}},
+			WantCount: 2,
+			WantItems: items,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.Res.ItemCount(); got != tt.WantCount {
+				t.Errorf("FindItemsResponse.ItemCount() = %d, want %d", got, tt.WantCount)
+			}
+			if got := tt.Res.Items(); !reflect.DeepEqual(got, tt.WantItems) {
+				t.Errorf("FindItemsResponse.Items() = %v, want %v", got, tt.WantItems)
+			}
+		})
+	}
+}
+
+func TestSearchItem_ViewURL(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name         string
+		URLs         []string
+		Want         string
+		Err          error
+		AffiliateErr error
+	}{
+		{
+			Name:         "AffiliateURL",
+			URLs:         []string{"https://www.ebay.com/itm/123?mkcid=1&mkrid=711-53200-19255-0&campid=1234567890"},
+			Want:         "https://www.ebay.com/itm/123?mkcid=1&mkrid=711-53200-19255-0&campid=1234567890",
+			AffiliateErr: nil,
+		},
+		{
+			Name:         "PlainURL",
+			URLs:         []string{"https://www.ebay.com/itm/123"},
+			Want:         "https://www.ebay.com/itm/123",
+			AffiliateErr: ErrNoAffiliateParams,
+		},
+		{Name: "Missing", URLs: nil, Err: ErrInvalidViewURL, AffiliateErr: ErrInvalidViewURL},
+		{Name: "Relative", URLs: []string{"/itm/123"}, Err: ErrInvalidViewURL, AffiliateErr: ErrInvalidViewURL},
+		{Name: "Invalid", URLs: []string{"https://www.ebay.com/%zz"}, Err: ErrInvalidViewURL, AffiliateErr: ErrInvalidViewURL},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			item := SearchItem{ViewItemURL: tc.URLs}
+			u, err := item.ViewURL()
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("SearchItem.ViewURL() error = %v, want %v", err, tc.Err)
+			}
+			if err == nil && u.String() != tc.Want {
+				t.Errorf("SearchItem.ViewURL() = %s, want %s", u, tc.Want)
+			}
+			u, err = item.AffiliateViewURL()
+			if !errors.Is(err, tc.AffiliateErr) {
+				t.Fatalf("SearchItem.AffiliateViewURL() error = %v, want %v", err, tc.AffiliateErr)
+			}
+			if err == nil && u.String() != tc.Want {
+				t.Errorf("SearchItem.AffiliateViewURL() = %s, want %s", u, tc.Want)
+			}
+		})
+	}
+}
+
+func TestSearchItem_Prices(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name     string
+		Item     SearchItem
+		Total    float64
+		Currency string
+		Err      error
+	}{
+		{
+			Name: "PriceAndShipping",
+			Item: SearchItem{
+				SellingStatus: []SellingStatus{{CurrentPrice: []Price{{CurrencyID: "USD", Value: "10.5"}}}},
+				ShippingInfo:  []ShippingInfo{{ShippingServiceCost: []Price{{CurrencyID: "USD", Value: "4.25"}}}},
+			},
+			Total:    14.75,
+			Currency: "USD",
+		},
+		{
+			Name:     "PriceOnly",
+			Item:     SearchItem{SellingStatus: []SellingStatus{{CurrentPrice: []Price{{CurrencyID: "EUR", Value: "20"}}}}},
+			Total:    20,
+			Currency: "EUR",
+		},
+		{Name: "MissingPrice", Item: SearchItem{}, Err: ErrInvalidPrice},
+		{
+			Name: "MalformedPrice",
+			Item: SearchItem{SellingStatus: []SellingStatus{{CurrentPrice: []Price{{CurrencyID: "USD", Value: "ten"}}}}},
+			Err:  ErrInvalidPrice,
+		},
+		{
+			Name: "MalformedShipping",
+			Item: SearchItem{
+				SellingStatus: []SellingStatus{{CurrentPrice: []Price{{CurrencyID: "USD", Value: "10"}}}},
+				ShippingInfo:  []ShippingInfo{{ShippingServiceCost: []Price{{CurrencyID: "USD", Value: ""}}}},
+			},
+			Err: ErrInvalidPrice,
+		},
+		{
+			Name: "CurrencyMismatch",
+			Item: SearchItem{
+				SellingStatus: []SellingStatus{{CurrentPrice: []Price{{CurrencyID: "USD", Value: "10"}}}},
+				ShippingInfo:  []ShippingInfo{{ShippingServiceCost: []Price{{CurrencyID: "EUR", Value: "5"}}}},
+			},
+			Err: ErrCurrencyMismatch,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			total, currency, err := tc.Item.TotalPrice()
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("SearchItem.TotalPrice() error = %v, want %v", err, tc.Err)
+			}
+			if total != tc.Total || currency != tc.Currency {
+				t.Errorf("SearchItem.TotalPrice() = %v, %q, want %v, %q", total, currency, tc.Total, tc.Currency)
+			}
+		})
+	}
+
+	t.Run("Unavailable", func(t *testing.T) {
+		t.Parallel()
+		item := SearchItem{SellingStatus: []SellingStatus{{}}, ShippingInfo: []ShippingInfo{{}}}
+		if got, ok := item.CurrentPrice(); ok {
+			t.Errorf("SearchItem.CurrentPrice() = %v, true, want false", got)
+		}
+		if got, ok := item.ShippingCost(); ok {
+			t.Errorf("SearchItem.ShippingCost() = %v, true, want false", got)
+		}
+	})
+}
+
+func TestSearchItem_Discount(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name     string
+		Item     SearchItem
+		Original Price
+		OK       bool
+		OnSale   bool
+	}{
+		{
+			Name: "Strikethrough",
+			Item: SearchItem{DiscountPriceInfo: []DiscountPriceInfo{{
+				OriginalRetailPrice: []Price{{CurrencyID: "USD", Value: "49.99"}},
+				PricingTreatment:    []string{"STP"},
+			}}},
+			Original: Price{CurrencyID: "USD", Value: "49.99"},
+			OK:       true,
+			OnSale:   true,
+		},
+		{
+			Name:   "TreatmentOnly",
+			Item:   SearchItem{DiscountPriceInfo: []DiscountPriceInfo{{PricingTreatment: []string{"MAP"}}}},
+			OnSale: true,
+		},
+		{Name: "NoTreatment", Item: SearchItem{DiscountPriceInfo: []DiscountPriceInfo{{PricingTreatment: []string{"NONE"}}}}},
+		{Name: "Empty", Item: SearchItem{DiscountPriceInfo: []DiscountPriceInfo{{}}}},
+		{Name: "Missing", Item: SearchItem{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if got, ok := tc.Item.OriginalRetailPrice(); got != tc.Original || ok != tc.OK {
+				t.Errorf("SearchItem.OriginalRetailPrice() = %v, %t, want %v, %t", got, ok, tc.Original, tc.OK)
+			}
+			if got := tc.Item.IsOnSale(); got != tc.OnSale {
+				t.Errorf("SearchItem.IsOnSale() = %t, want %t", got, tc.OnSale)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name     string
+		Distance Distance
+		WantKm   float64
+		WantMi   float64
+		Err      error
+	}{
+		{Name: "Miles", Distance: Distance{Unit: "mi", Value: "10"}, WantKm: 16.09344, WantMi: 10},
+		{Name: "Kilometers", Distance: Distance{Unit: "km", Value: "16.09344"}, WantKm: 16.09344, WantMi: 10},
+		{Name: "InvalidValue", Distance: Distance{Unit: "km", Value: "far"}, Err: ErrInvalidDistance},
+		{Name: "UnknownUnit", Distance: Distance{Unit: "ft", Value: "10"}, Err: ErrInvalidDistance},
+	}
+	const epsilon = 1e-9
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			km, err := tc.Distance.Kilometers()
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("Distance.Kilometers() error = %v, want %v", err, tc.Err)
+			}
+			if math.Abs(km-tc.WantKm) > epsilon {
+				t.Errorf("Distance.Kilometers() = %v, want %v", km, tc.WantKm)
+			}
+			mi, err := tc.Distance.Miles()
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("Distance.Miles() error = %v, want %v", err, tc.Err)
+			}
+			if math.Abs(mi-tc.WantMi) > epsilon {
+				t.Errorf("Distance.Miles() = %v, want %v", mi, tc.WantMi)
+			}
+		})
+	}
+}
+
+func TestSearchItem_DistanceValue(t *testing.T) {
+	t.Parallel()
+	item := SearchItem{Distance: []Distance{{Unit: "mi", Value: "12.5"}}}
+	v, unit, ok := item.DistanceValue()
+	if v != 12.5 || unit != "mi" || !ok {
+		t.Errorf("SearchItem.DistanceValue() = %v, %q, %t, want 12.5, \"mi\", true", v, unit, ok)
+	}
+	for _, item := range []SearchItem{{}, {Distance: []Distance{{Unit: "mi", Value: "far"}}}} {
+		if v, unit, ok := item.DistanceValue(); ok {
+			t.Errorf("SearchItem.DistanceValue() = %v, %q, %t, want false", v, unit, ok)
+		}
+	}
+}
+
+func TestSellerInfo_Feedback(t *testing.T) {
+	t.Parallel()
+	t.Run("Valid", func(t *testing.T) {
+		t.Parallel()
+		s := SellerInfo{
+			FeedbackScore:           []string{"1234"},
+			PositiveFeedbackPercent: []string{"99.8"},
+			TopRatedSeller:          []string{"true"},
+		}
+		score, err := s.FeedbackScoreInt()
+		if err != nil || score != 1234 {
+			t.Errorf("SellerInfo.FeedbackScoreInt() = %d, %v, want 1234, nil", score, err)
+		}
+		pct, err := s.PositiveFeedbackFloat()
+		if err != nil || pct != 99.8 {
+			t.Errorf("SellerInfo.PositiveFeedbackFloat() = %v, %v, want 99.8, nil", pct, err)
+		}
+		if !s.IsTopRated() {
+			t.Error("SellerInfo.IsTopRated() = false, want true")
+		}
+	})
+
+	t.Run("InvalidFeedbackError", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range []SellerInfo{
+			{},
+			{FeedbackScore: []string{"high"}, PositiveFeedbackPercent: []string{"most"}},
+		} {
+			if _, err := s.FeedbackScoreInt(); !errors.Is(err, ErrInvalidFeedback) {
+				t.Errorf("SellerInfo.FeedbackScoreInt() error = %v, want %v", err, ErrInvalidFeedback)
+			}
+			if _, err := s.PositiveFeedbackFloat(); !errors.Is(err, ErrInvalidFeedback) {
+				t.Errorf("SellerInfo.PositiveFeedbackFloat() error = %v, want %v", err, ErrInvalidFeedback)
+			}
+			if s.IsTopRated() {
+				t.Error("SellerInfo.IsTopRated() = true, want false")
+			}
+		}
+	})
+}
+
+func TestResponses_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+	inner, err := os.ReadFile(filepath.Join("testdata", "find_items_response.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testCases := []struct {
+		Key string
+		Res func() ResultProvider
+	}{
+		{Key: "findItemsAdvancedResponse", Res: func() ResultProvider { return &FindItemsAdvancedResponse{} }},
+		{Key: "findItemsByCategoryResponse", Res: func() ResultProvider { return &FindItemsByCategoryResponse{} }},
+		{Key: "findItemsByKeywordsResponse", Res: func() ResultProvider { return &FindItemsByKeywordsResponse{} }},
+		{Key: "findItemsByProductResponse", Res: func() ResultProvider { return &FindItemsByProductResponse{} }},
+		{Key: "findItemsIneBayStoresResponse", Res: func() ResultProvider { return &FindItemsInEBayStoresResponse{} }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Key, func(t *testing.T) {
+			t.Parallel()
+			body := []byte(`{"` + tc.Key + `":` + string(inner) + `}`)
+			first := tc.Res()
+			if err := json.Unmarshal(body, first); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v, want nil", err)
+			}
+			if got := first.Results(); len(got) != 1 || len(got[0].Items()) != 1 {
+				t.Fatalf("%T.Results() = %v, want one response with one item", first, got)
+			}
+			data, err := json.Marshal(first)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v, want nil", err)
+			}
+			second := tc.Res()
+			if err := json.Unmarshal(data, second); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v, want nil", err)
+			}
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("%T round trip = %+v, want %+v", first, second, first)
+			}
+			var want, got any
+			if err := json.Unmarshal(body, &want); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			jsonSubset(t, tc.Key, want, got)
+		})
+	}
+}
+
+// jsonSubset reports an error for each value in want that is missing from or different in got.
+// Timestamps are compared as times, since their encoding is not preserved.
+func jsonSubset(t *testing.T, path string, want, got any) {
+	t.Helper()
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			t.Errorf("%s = %v, want object", path, got)
+			return
+		}
+		for k, v := range w {
+			jsonSubset(t, path+"."+k, v, g[k])
+		}
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			t.Errorf("%s = %v, want %v", path, got, want)
+			return
+		}
+		for i := range w {
+			jsonSubset(t, fmt.Sprintf("%s[%d]", path, i), w[i], g[i])
+		}
+	default:
+		if reflect.DeepEqual(want, got) {
+			return
+		}
+		ws, wok := want.(string)
+		gs, gok := got.(string)
+		if wok && gok {
+			wt, werr := time.Parse(time.RFC3339, ws)
+			gt, gerr := time.Parse(time.RFC3339, gs)
+			if werr == nil && gerr == nil && wt.Equal(gt) {
+				return
+			}
+		}
+		t.Errorf("%s = %v, want %v", path, got, want)
+	}
+}
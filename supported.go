@@ -0,0 +1,104 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import "slices"
+
+// itemFilterNames are the item filter names documented by the eBay Finding API.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/ItemFilterType.html.
+var itemFilterNames = []string{
+	"AuthorizedSellerOnly",
+	"AvailableTo",
+	"BestOfferOnly",
+	"CharityOnly",
+	"Condition",
+	"Currency",
+	"EndTimeFrom",
+	"EndTimeTo",
+	"ExcludeAutoPay",
+	"ExcludeCategory",
+	"ExcludeSeller",
+	"ExpeditedShippingType",
+	"FeaturedOnly",
+	"FeedbackScoreMax",
+	"FeedbackScoreMin",
+	"FreeShippingOnly",
+	"GetItFastOnly",
+	"HideDuplicateItems",
+	"ListedIn",
+	"ListingType",
+	"LocalPickupOnly",
+	"LocalSearchOnly",
+	"LocatedIn",
+	"LotsOnly",
+	"MaxBids",
+	"MaxDistance",
+	"MaxHandlingTime",
+	"MaxPrice",
+	"MaxQuantity",
+	"MinBids",
+	"MinPrice",
+	"MinQuantity",
+	"ModTimeFrom",
+	"OutletSellerOnly",
+	"PaymentMethod",
+	"ReturnsAcceptedOnly",
+	"Seller",
+	"SellerBusinessType",
+	"SoldItemsOnly",
+	"StartTimeFrom",
+	"StartTimeTo",
+	"TopRatedSellerOnly",
+	"ValueBoxInventory",
+	"WorldOfGoodOnly",
+}
+
+// sortOrders are the sort orders documented by the eBay Finding API.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/SortOrderType.html.
+var sortOrders = []string{
+	"BestMatch",
+	"BidCountFewest",
+	"BidCountMost",
+	"CountryAscending",
+	"CountryDescending",
+	"CurrentPriceHighest",
+	"DistanceNearest",
+	"EndTimeSoonest",
+	"PricePlusShippingHighest",
+	"PricePlusShippingLowest",
+	"StartTimeNewest",
+	"WatchCountDecreaseSort",
+}
+
+// outputSelectors are the output selectors documented by the eBay Finding API.
+// See https://developer.ebay.com/Devzone/finding/CallRef/types/OutputSelectorType.html.
+var outputSelectors = []string{
+	"AspectHistogram",
+	"CategoryHistogram",
+	"ConditionHistogram",
+	"GalleryInfo",
+	"PictureURLLarge",
+	"PictureURLSuperSize",
+	"SellerInfo",
+	"StoreInfo",
+	"UnitPriceInfo",
+}
+
+// SupportedItemFilters returns the item filter names supported by the eBay Finding API,
+// such as AuthorizedSellerOnly and AvailableTo. The returned slice may be modified.
+func SupportedItemFilters() []string {
+	return slices.Clone(itemFilterNames)
+}
+
+// SupportedSortOrders returns the sort orders supported by the eBay Finding API,
+// such as BestMatch and EndTimeSoonest. The returned slice may be modified.
+func SupportedSortOrders() []string {
+	return slices.Clone(sortOrders)
+}
+
+// SupportedOutputSelectors returns the output selectors supported by the eBay Finding API,
+// such as AspectHistogram and SellerInfo. The returned slice may be modified.
+func SupportedOutputSelectors() []string {
+	return slices.Clone(outputSelectors)
+}
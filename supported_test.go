@@ -0,0 +1,40 @@
+// Copyright 2023 Matthew P. Dargan.
+// SPDX-License-Identifier: Apache-2.0
+
+package ebay
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSupported(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		Name string
+		Fn   func() []string
+		Want []string
+	}{
+		{Name: "ItemFilters", Fn: SupportedItemFilters, Want: []string{"AuthorizedSellerOnly", "AvailableTo", "MaxPrice"}},
+		{Name: "SortOrders", Fn: SupportedSortOrders, Want: []string{"BestMatch", "BidCountFewest", "WatchCountDecreaseSort"}},
+		{Name: "OutputSelectors", Fn: SupportedOutputSelectors, Want: []string{"AspectHistogram", "SellerInfo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.Fn()
+			for _, w := range tt.Want {
+				if !slices.Contains(got, w) {
+					t.Errorf("Supported%s() = %v, want it to contain %s", tt.Name, got, w)
+				}
+			}
+			if !slices.IsSorted(got) {
+				t.Errorf("Supported%s() = %v, want sorted", tt.Name, got)
+			}
+			got[0] = "Modified"
+			if again := tt.Fn(); again[0] == "Modified" {
+				t.Errorf("Supported%s() returned a shared slice", tt.Name)
+			}
+		})
+	}
+}